@@ -7,22 +7,30 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
 
+	"golang.org/x/oauth2"
 	"gopkg.in/square/go-jose.v2"
 	"gopkg.in/square/go-jose.v2/jwt"
 )
 
+// appInstallationTokenRefreshWindow is how long before its expiry an
+// installation token is replaced by a freshly minted one.
+const appInstallationTokenRefreshWindow = 5 * time.Minute
+
 // GenerateOAuthTokenFromApp generates a GitHub OAuth access token from a set of valid GitHub App credentials.
 // The returned token can be used to interact with both GitHub's REST and GraphQL APIs.
-func GenerateOAuthTokenFromApp(baseURL, appID, appInstallationID, pemData string) (string, error) {
+func GenerateOAuthTokenFromApp(client *http.Client, baseURL, appID, appInstallationID, pemData string) (string, error) {
 	appJWT, err := generateAppJWT(appID, time.Now(), []byte(pemData))
 	if err != nil {
 		return "", err
 	}
 
-	token, err := getInstallationAccessToken(baseURL, appJWT, appInstallationID)
+	token, _, err := getInstallationAccessToken(client, baseURL, appJWT, appInstallationID)
 	if err != nil {
 		return "", err
 	}
@@ -30,46 +38,248 @@ func GenerateOAuthTokenFromApp(baseURL, appID, appInstallationID, pemData string
 	return token, nil
 }
 
-func getInstallationAccessToken(baseURL string, jwt string, installationID string) (string, error) {
-	if baseURL != "https://api.github.com/" {
-		baseURL += "api/v3/"
+// GetAppInstallationIDForOwner looks up the ID of the installation of a GitHub
+// App on the given organization or user account.
+func GetAppInstallationIDForOwner(client *http.Client, baseURL, appID, owner, pemData string) (string, error) {
+	appJWT, err := generateAppJWT(appID, time.Now(), []byte(pemData))
+	if err != nil {
+		return "", err
+	}
+
+	return getInstallationIDForOwner(client, baseURL, appJWT, owner)
+}
+
+// appInstallationTokenSource is an oauth2.TokenSource which remembers a set of
+// GitHub App credentials and mints a new installation token whenever the
+// current one is about to expire. Installation tokens are only valid for one
+// hour, which is shorter than a large apply may take.
+type appInstallationTokenSource struct {
+	client         *http.Client
+	baseURL        string
+	appID          string
+	installationID string
+	pemData        []byte
+
+	m     sync.Mutex
+	token *oauth2.Token
+}
+
+func newAppInstallationTokenSource(client *http.Client, baseURL, appID, appInstallationID, pemData string) *appInstallationTokenSource {
+	return &appInstallationTokenSource{
+		client:         client,
+		baseURL:        baseURL,
+		appID:          appID,
+		installationID: appInstallationID,
+		pemData:        []byte(pemData),
+	}
+}
+
+// Token returns the cached installation token, minting a new one when none
+// has been created yet or when the cached one expires soon.
+func (ts *appInstallationTokenSource) Token() (*oauth2.Token, error) {
+	ts.m.Lock()
+	defer ts.m.Unlock()
+
+	if ts.token != nil && time.Until(ts.token.Expiry) > appInstallationTokenRefreshWindow {
+		return ts.token, nil
+	}
+
+	appJWT, err := generateAppJWT(ts.appID, time.Now(), ts.pemData)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresAt, err := getInstallationAccessToken(ts.client, ts.baseURL, appJWT, ts.installationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresAt.IsZero() {
+		// Installation tokens are documented to expire after one hour
+		expiresAt = time.Now().Add(time.Hour)
+	}
+	log.Printf("[DEBUG] Minted GitHub App installation token for installation %s, expiring at %s",
+		ts.installationID, expiresAt)
+
+	ts.token = &oauth2.Token{
+		AccessToken: accessToken,
+		TokenType:   "token",
+		Expiry:      expiresAt,
+	}
+
+	return ts.token, nil
+}
+
+// invalidate drops the cached token if it is still the given one, so that the
+// next call to Token mints a new installation token.
+func (ts *appInstallationTokenSource) invalidate(accessToken string) {
+	ts.m.Lock()
+	defer ts.m.Unlock()
+
+	if ts.token != nil && ts.token.AccessToken == accessToken {
+		ts.token = nil
+	}
+}
+
+// appInstallationTransport authenticates requests with a token from an
+// appInstallationTokenSource. When GitHub rejects the token with a
+// 401 Unauthorized, a new token is minted and the request is retried once.
+type appInstallationTransport struct {
+	transport http.RoundTripper
+	source    *appInstallationTokenSource
+}
+
+func newAppInstallationTransport(source *appInstallationTokenSource, rt http.RoundTripper) *appInstallationTransport {
+	return &appInstallationTransport{
+		transport: rt,
+		source:    source,
+	}
+}
+
+func (t *appInstallationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(authorizeRequest(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// A request body which has already been consumed can only be sent
+	// again when it can be recreated.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	log.Printf("[DEBUG] GitHub App installation token was rejected, minting a new one before retrying %s %s",
+		req.Method, req.URL.Path)
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	t.source.invalidate(token.AccessToken)
+	token, err = t.source.Token()
+	if err != nil {
+		return nil, err
 	}
 
-	url := fmt.Sprintf("%sapp/installations/%s/access_tokens", baseURL, installationID)
+	retry := authorizeRequest(req, token)
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return t.transport.RoundTrip(retry)
+}
+
+// authorizeRequest returns a copy of req carrying the given token, as an
+// http.RoundTripper must not modify the request it was given.
+func authorizeRequest(req *http.Request, token *oauth2.Token) *http.Request {
+	r := req.Clone(req.Context())
+	token.SetAuthHeader(r)
+	return r
+}
+
+func getInstallationAccessToken(client *http.Client, baseURL string, jwt string, installationID string) (string, time.Time, error) {
+	url := appAPIURL(baseURL, fmt.Sprintf("app/installations/%s/access_tokens", installationID))
 
 	req, err := http.NewRequest(http.MethodPost, url, nil)
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
 	}
 
 	req.Header.Add("Accept", "application/vnd.github.v3+json")
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", jwt))
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := client.Do(req)
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
 	}
 	defer func() { _ = res.Body.Close() }()
 
 	resBytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
 	}
 
 	if res.StatusCode != http.StatusCreated {
-		return "", fmt.Errorf("failed to create OAuth token from GitHub App: %s", string(resBytes))
+		return "", time.Time{}, fmt.Errorf("failed to create OAuth token from GitHub App: %s", string(resBytes))
 	}
 
 	resData := struct {
-		Token string `json:"token"`
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
 	}{}
 
 	err = json.Unmarshal(resBytes, &resData)
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
+	}
+
+	return resData.Token, resData.ExpiresAt, nil
+}
+
+// getInstallationIDForOwner resolves the ID of the GitHub App installation on
+// the given organization or user account, trying the organization endpoint
+// first.
+func getInstallationIDForOwner(client *http.Client, baseURL string, jwt string, owner string) (string, error) {
+	for _, path := range []string{"orgs/%s/installation", "users/%s/installation"} {
+		url := appAPIURL(baseURL, fmt.Sprintf(path, owner))
+
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return "", err
+		}
+
+		req.Header.Add("Accept", "application/vnd.github.v3+json")
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", jwt))
+
+		res, err := client.Do(req)
+		if err != nil {
+			return "", err
+		}
+
+		resBytes, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if err != nil {
+			return "", err
+		}
+
+		if res.StatusCode == http.StatusNotFound {
+			continue
+		}
+
+		if res.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("failed to look up GitHub App installation for %s: %s", owner, string(resBytes))
+		}
+
+		resData := struct {
+			ID int64 `json:"id"`
+		}{}
+
+		err = json.Unmarshal(resBytes, &resData)
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.FormatInt(resData.ID, 10), nil
+	}
+
+	return "", fmt.Errorf("the GitHub App is not installed on %q; install it on that organization or user, "+
+		"or set app_auth.installation_id explicitly", owner)
+}
+
+// appAPIURL returns the URL of a GitHub App endpoint, accounting for the
+// /api/v3/ prefix used by GitHub Enterprise Server.
+func appAPIURL(baseURL string, path string) string {
+	if baseURL != "https://api.github.com/" {
+		baseURL += "api/v3/"
 	}
 
-	return resData.Token, nil
+	return baseURL + path
 }
 
 func generateAppJWT(appID string, now time.Time, pemData []byte) (string, error) {
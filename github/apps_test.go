@@ -1,15 +1,19 @@
 package github
 
 import (
+	"context"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/go-github/v53/github"
 	"gopkg.in/square/go-jose.v2"
 	"gopkg.in/square/go-jose.v2/jwt"
 )
@@ -151,13 +155,13 @@ func TestGetInstallationAccessToken(t *testing.T) {
 				"Authorization": fmt.Sprintf("Bearer %s", fakeJWT),
 			},
 
-			ResponseBody: fmt.Sprintf(`{"token": "%s"}`, expectedAccessToken),
+			ResponseBody: fmt.Sprintf(`{"token": "%s", "expires_at": "2016-07-11T22:14:10Z"}`, expectedAccessToken),
 			StatusCode:   201,
 		},
 	})
 	defer ts.Close()
 
-	accessToken, err := getInstallationAccessToken(ts.URL+"/", fakeJWT, testGitHubAppInstallationID)
+	accessToken, expiresAt, err := getInstallationAccessToken(http.DefaultClient, ts.URL+"/", fakeJWT, testGitHubAppInstallationID)
 
 	if err != nil {
 		t.Logf("Unexpected error: %s", err)
@@ -168,4 +172,168 @@ func TestGetInstallationAccessToken(t *testing.T) {
 		t.Logf("Unexpected access token - Found: %s - Expected: %s", accessToken, expectedAccessToken)
 		t.Fail()
 	}
+
+	expectedExpiresAt := time.Date(2016, 7, 11, 22, 14, 10, 0, time.UTC)
+	if !expiresAt.Equal(expectedExpiresAt) {
+		t.Logf("Unexpected expiry - Found: %s - Expected: %s", expiresAt, expectedExpiresAt)
+		t.Fail()
+	}
+}
+
+func TestGetInstallationIDForOwner(t *testing.T) {
+	fakeJWT := "fake.jwt.token"
+
+	t.Run("finds an organization installation", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/api/v3/orgs/tada/installation",
+				ExpectedMethod: "GET",
+				ExpectedHeaders: map[string]string{
+					"Authorization": fmt.Sprintf("Bearer %s", fakeJWT),
+				},
+				ResponseBody: fmt.Sprintf(`{"id": %s}`, testGitHubAppInstallationID),
+				StatusCode:   200,
+			},
+		})
+		defer ts.Close()
+
+		installationID, err := getInstallationIDForOwner(http.DefaultClient, ts.URL+"/", fakeJWT, "tada")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if installationID != testGitHubAppInstallationID {
+			t.Fatalf("Unexpected installation ID - Found: %s - Expected: %s", installationID, testGitHubAppInstallationID)
+		}
+	})
+
+	t.Run("falls back to a user installation", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/api/v3/orgs/octocat/installation",
+				ResponseBody: `{"message": "Not Found"}`,
+				StatusCode:   404,
+			},
+			{
+				ExpectedUri:  "/api/v3/users/octocat/installation",
+				ResponseBody: fmt.Sprintf(`{"id": %s}`, testGitHubAppInstallationID),
+				StatusCode:   200,
+			},
+		})
+		defer ts.Close()
+
+		installationID, err := getInstallationIDForOwner(http.DefaultClient, ts.URL+"/", fakeJWT, "octocat")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if installationID != testGitHubAppInstallationID {
+			t.Fatalf("Unexpected installation ID - Found: %s - Expected: %s", installationID, testGitHubAppInstallationID)
+		}
+	})
+
+	t.Run("reports when the app is not installed", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/api/v3/orgs/octocat/installation",
+				ResponseBody: `{"message": "Not Found"}`,
+				StatusCode:   404,
+			},
+			{
+				ExpectedUri:  "/api/v3/users/octocat/installation",
+				ResponseBody: `{"message": "Not Found"}`,
+				StatusCode:   404,
+			},
+		})
+		defer ts.Close()
+
+		_, err := getInstallationIDForOwner(http.DefaultClient, ts.URL+"/", fakeJWT, "octocat")
+		if err == nil || !strings.Contains(err.Error(), "not installed") {
+			t.Fatalf("Expected a not installed error, got: %v", err)
+		}
+	})
+}
+
+func TestAppInstallationTokenSource(t *testing.T) {
+	accessTokenUri := fmt.Sprintf("/api/v3/app/installations/%s/access_tokens", testGitHubAppInstallationID)
+
+	t.Run("reuses a token until it is about to expire", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  accessTokenUri,
+				ResponseBody: fmt.Sprintf(`{"token": "expiring", "expires_at": "%s"}`, time.Now().Add(time.Minute).Format(time.RFC3339)),
+				StatusCode:   201,
+			},
+			{
+				ExpectedUri:  accessTokenUri,
+				ResponseBody: fmt.Sprintf(`{"token": "fresh", "expires_at": "%s"}`, time.Now().Add(time.Hour).Format(time.RFC3339)),
+				StatusCode:   201,
+			},
+		})
+		defer ts.Close()
+
+		source := newAppInstallationTokenSource(http.DefaultClient, ts.URL+"/", testGitHubAppID, testGitHubAppInstallationID, string(testGitHubAppPrivateKeyPemData))
+
+		for _, expected := range []string{"expiring", "fresh", "fresh"} {
+			token, err := source.Token()
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if token.AccessToken != expected {
+				t.Fatalf("Unexpected access token - Found: %s - Expected: %s", token.AccessToken, expected)
+			}
+		}
+	})
+
+	t.Run("retries a request once with a new token after a 401", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  accessTokenUri,
+				ResponseBody: fmt.Sprintf(`{"token": "revoked", "expires_at": "%s"}`, time.Now().Add(time.Hour).Format(time.RFC3339)),
+				StatusCode:   201,
+			},
+			{
+				ExpectedUri:    "/api/v3/orgs/tada/repos",
+				ExpectedMethod: "POST",
+				ExpectedHeaders: map[string]string{
+					"Authorization": "token revoked",
+				},
+				ResponseBody: `{"message": "Bad credentials"}`,
+				StatusCode:   401,
+			},
+			{
+				ExpectedUri:  accessTokenUri,
+				ResponseBody: fmt.Sprintf(`{"token": "fresh", "expires_at": "%s"}`, time.Now().Add(time.Hour).Format(time.RFC3339)),
+				StatusCode:   201,
+			},
+			{
+				ExpectedUri:    "/api/v3/orgs/tada/repos",
+				ExpectedMethod: "POST",
+				ExpectedHeaders: map[string]string{
+					"Authorization": "token fresh",
+				},
+				ExpectedBody: []byte(`{"name":"example"}
+`),
+				ResponseBody: `{"id": 1234}`,
+				StatusCode:   201,
+			},
+		})
+		defer ts.Close()
+
+		source := newAppInstallationTokenSource(http.DefaultClient, ts.URL+"/", testGitHubAppID, testGitHubAppInstallationID, string(testGitHubAppPrivateKeyPemData))
+		client := github.NewClient(&http.Client{Transport: newAppInstallationTransport(source, http.DefaultTransport)})
+		u, _ := url.Parse(ts.URL + "/api/v3/")
+		client.BaseURL = u
+
+		r, _, err := client.Repositories.Create(context.Background(), "tada", &github.Repository{
+			Name: github.String("example"),
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if r.GetID() != 1234 {
+			t.Fatalf("Expected ID to be 1234, got: %d", r.GetID())
+		}
+	})
 }
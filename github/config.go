@@ -2,27 +2,52 @@ package github
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"log"
 	"net/http"
 	"net/url"
 	"path"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/google/go-github/v53/github"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
+	"github.com/hashicorp/go-version"
 	"github.com/shurcooL/githubv4"
 	"golang.org/x/oauth2"
 )
 
+// defaultAPIVersion is the GitHub REST API version requested unless the
+// api_version argument says otherwise
+const defaultAPIVersion = "2022-11-28"
+
 type Config struct {
-	Token            string
-	Owner            string
-	BaseURL          string
-	Insecure         bool
-	WriteDelay       time.Duration
-	ReadDelay        time.Duration
-	ParallelRequests bool
+	Token                string
+	Owner                string
+	BaseURL              string
+	Insecure             bool
+	TLSConfig            *tls.Config
+	APIVersion           string
+	ExtraHeaders         map[string]string
+	WriteDelay           time.Duration
+	ReadDelay            time.Duration
+	ParallelRequests     bool
+	MaxConcurrentReads   int
+	RateLimitThreshold   int
+	RateLimitReserve     int
+	MaxRetries           int
+	RetryDelay           time.Duration
+	MaxRetryDelay        time.Duration
+	RetryJitter          bool
+	RetryableStatusCodes []int
+	ResponseCache        ResponseCache
+	AuditLog             *AuditLog
+	LogRedactPatterns    []*regexp.Regexp
+	ReadOnly             bool
+	ValidatePermissions  bool
+	AppTokenSource       *appInstallationTokenSource
 }
 
 type Owner struct {
@@ -30,41 +55,158 @@ type Owner struct {
 	id             int64
 	v3client       *github.Client
 	v4client       *githubv4.Client
+	httpClient     *http.Client
 	StopContext    context.Context
 	IsOrganization bool
+
+	// ghesVersion is the version of the GitHub Enterprise Server the
+	// provider is connected to, nil for github.com
+	ghesVersion *version.Version
+
+	// owners caches the owners other than name that resources are managed
+	// for, shared by every copy of the provider meta
+	owners *ownerCache
+
+	// customProperties caches the custom property values of the
+	// repositories of organizations, shared by every copy of the provider
+	// meta
+	customProperties *customPropertyCache
+
+	// resourceType is the type of the resource or data source whose
+	// functions were handed this copy of the provider meta
+	resourceType string
 }
 
-func RateLimitedHTTPClient(client *http.Client, writeDelay time.Duration, readDelay time.Duration, parallelRequests bool) *http.Client {
+func (c *Config) RateLimitedHTTPClient(client *http.Client) *http.Client {
+
+	retryableStatusCodes := c.RetryableStatusCodes
+	if len(retryableStatusCodes) == 0 {
+		retryableStatusCodes = defaultRetryableStatusCodes
+	}
+
+	var etagOptions []EtagTransportOption
+	if c.ResponseCache != nil {
+		etagOptions = append(etagOptions, WithResponseCache(c.ResponseCache), WithResponseCacheScope(c.responseCacheScope()))
+	}
 
-	client.Transport = NewEtagTransport(client.Transport)
-	client.Transport = NewRateLimitTransport(client.Transport, WithWriteDelay(writeDelay), WithReadDelay(readDelay), WithParallelRequests(parallelRequests))
-	client.Transport = logging.NewTransport("GitHub", client.Transport)
-	client.Transport = newPreviewHeaderInjectorTransport(map[string]string{
+	client.Transport = newRecorderTransportFromEnv(client.Transport)
+	if c.AuditLog != nil {
+		client.Transport = NewAuditTransport(client.Transport, c.AuditLog)
+	}
+	client.Transport = NewEtagTransport(client.Transport, etagOptions...)
+	client.Transport = NewRateLimitTransport(client.Transport, WithWriteDelay(c.WriteDelay), WithReadDelay(c.ReadDelay), WithParallelRequests(c.ParallelRequests), WithMaxConcurrentReads(c.MaxConcurrentReads), WithRateLimitBudget(c.RateLimitThreshold, c.RateLimitReserve))
+	client.Transport = NewRetryTransport(client.Transport, WithMaxRetries(c.MaxRetries), WithRetryBackoff(c.RetryDelay, c.MaxRetryDelay), WithRetryJitter(c.RetryJitter), WithRetryableStatusCodes(retryableStatusCodes...))
+	client.Transport = NewPermissionHintTransport(client.Transport)
+	if c.ReadOnly {
+		client.Transport = NewReadOnlyTransport(client.Transport)
+	}
+	client.Transport = NewLoggingTransport("GitHub", client.Transport, c.LogRedactPatterns...)
+	client.Transport = newHeaderInjectorTransport(map[string]string{
 		// TODO: remove when Stone Crop preview is moved to general availability in the GraphQL API
 		"Accept": "application/vnd.github.stone-crop-preview+json",
-	}, client.Transport)
+	}, c.ExtraHeaders, c.APIVersion, client.Transport)
 
 	return client
 }
 
 func (c *Config) AuthenticatedHTTPClient() *http.Client {
 
-	ctx := context.Background()
+	if c.AppTokenSource != nil {
+		// GitHub App installation tokens expire, so they are minted
+		// on demand instead of being handed over as a static token
+		client := &http.Client{Transport: newAppInstallationTransport(c.AppTokenSource, c.Transport())}
+		return c.RateLimitedHTTPClient(client)
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: c.Transport()})
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: c.Token},
 	)
 	client := oauth2.NewClient(ctx, ts)
 
-	return RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.ParallelRequests)
+	return c.RateLimitedHTTPClient(client)
+}
+
+// responseCacheScope identifies the credentials of the provider in the keys
+// of the response cache, which only keeps a hash of it
+func (c *Config) responseCacheScope() string {
+	if c.AppTokenSource != nil {
+		return "app " + c.AppTokenSource.appID + " " + c.AppTokenSource.installationID
+	}
+	if c.Token != "" {
+		return "token " + c.Token
+	}
+	return ""
 }
 
 func (c *Config) Anonymous() bool {
-	return c.Token == ""
+	return c.Token == "" && c.AppTokenSource == nil
 }
 
 func (c *Config) AnonymousHTTPClient() *http.Client {
-	client := &http.Client{Transport: &http.Transport{}}
-	return RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.ParallelRequests)
+	client := &http.Client{Transport: c.Transport()}
+	return c.RateLimitedHTTPClient(client)
+}
+
+// Transport returns the base transport used for every connection to GitHub,
+// honouring the configured TLS settings and the insecure flag.
+func (c *Config) Transport() http.RoundTripper {
+	return newHTTPTransport(c.TLSConfig, c.Insecure)
+}
+
+func newHTTPTransport(tlsConfig *tls.Config, insecure bool) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig.Clone()
+	}
+
+	if insecure {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		log.Printf("[WARN] insecure is set; TLS certificates presented by GitHub will not be verified")
+		transport.TLSClientConfig.InsecureSkipVerify = true // #nosec G402
+	}
+
+	return transport
+}
+
+// NewTLSConfig builds the TLS settings for connections to GitHub from PEM
+// encoded data. caCertPEM holds additional certificate authorities to trust,
+// clientCert and clientKey a client certificate for mutual TLS. It returns nil
+// when no custom settings are needed.
+func NewTLSConfig(caCertPEM, clientCert, clientKey string) (*tls.Config, error) {
+	if caCertPEM == "" && clientCert == "" && clientKey == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caCertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			log.Printf("[WARN] Unable to load system certificate pool, only trusting the configured CA certificates: %s", err)
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caCertPEM)) {
+			return nil, fmt.Errorf("no valid PEM encoded certificates found in the CA certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if clientCert != "" || clientKey != "" {
+		if clientCert == "" || clientKey == "" {
+			return nil, fmt.Errorf("client_cert and client_key must be set together")
+		}
+		cert, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
 }
 
 func (c *Config) NewGraphQLClient(client *http.Client) (*githubv4.Client, error) {
@@ -83,6 +225,16 @@ func (c *Config) NewGraphQLClient(client *http.Client) (*githubv4.Client, error)
 	return githubv4.NewEnterpriseClient(uv4.String(), client), nil
 }
 
+// IsGHES reports whether base_url points to a GitHub Enterprise Server
+// rather than github.com.
+func (c *Config) IsGHES() bool {
+	u, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return false
+	}
+	return u.String() != "https://api.github.com/"
+}
+
 func (c *Config) NewRESTClient(client *http.Client) (*github.Client, error) {
 
 	uv3, err := url.Parse(c.BaseURL)
@@ -127,6 +279,41 @@ func (c *Config) ConfigureOwner(owner *Owner) (*Owner, error) {
 	return owner, nil
 }
 
+// CheckPermissions probes GitHub with the configured credentials, so that
+// credentials which are rejected or lack permissions are reported at configure
+// time instead of by the first resource using them. Tokens are checked against
+// the authenticated user, GitHub App installations against the repositories
+// they can access.
+func (c *Config) CheckPermissions(owner *Owner) error {
+	ctx := context.Background()
+
+	if c.AppTokenSource != nil {
+		_, _, err := owner.v3client.Apps.ListRepos(ctx, &github.ListOptions{PerPage: 1})
+		if err != nil {
+			return fmt.Errorf("unable to validate the permissions of the GitHub App installation: %s", err)
+		}
+		return nil
+	}
+
+	_, resp, err := owner.v3client.Users.Get(ctx, "")
+	if err != nil {
+		return fmt.Errorf("unable to validate the permissions of the token: %s", err)
+	}
+
+	if _, ok := resp.Header["X-Oauth-Scopes"]; !ok {
+		log.Printf("[INFO] Token has no OAuth scopes, its permissions are checked per request")
+		return nil
+	}
+
+	scopes := splitHeaderList(resp.Header.Get("X-OAuth-Scopes"))
+	log.Printf("[INFO] Token has the OAuth scopes: %s", strings.Join(scopes, ", "))
+	if c.Owner != "" && !containsAny(scopes, []string{"read:org", "write:org", "admin:org"}) {
+		log.Printf("[WARN] Token has none of the read:org, write:org or admin:org scopes; "+
+			"organization resources will fail if %s is an organization", c.Owner)
+	}
+	return nil
+}
+
 // Meta returns the meta parameter that is passed into subsequent resources
 // https://godoc.org/github.com/hashicorp/terraform-plugin-sdk/helper/schema#ConfigureFunc
 func (c *Config) Meta() (interface{}, error) {
@@ -151,11 +338,23 @@ func (c *Config) Meta() (interface{}, error) {
 	var owner Owner
 	owner.v4client = v4client
 	owner.v3client = v3client
+	owner.owners = newOwnerCache()
+	owner.customProperties = newCustomPropertyCache()
+	// A client without GitHub credentials, used for GitHub App token exchanges
+	owner.httpClient = &http.Client{Transport: newHeaderInjectorTransport(nil, c.ExtraHeaders, c.APIVersion, NewLoggingTransport("GitHub", c.Transport(), c.LogRedactPatterns...))}
+
+	c.configureGHESVersion(&owner)
 
 	if c.Anonymous() {
 		log.Printf("[INFO] No token present; configuring anonymous owner.")
 		return &owner, nil
 	} else {
+		if c.ValidatePermissions {
+			if err := c.CheckPermissions(&owner); err != nil {
+				return &owner, err
+			}
+		}
+
 		_, err = c.ConfigureOwner(&owner)
 		if err != nil {
 			return &owner, err
@@ -165,19 +364,27 @@ func (c *Config) Meta() (interface{}, error) {
 	}
 }
 
-type previewHeaderInjectorTransport struct {
+// headerInjectorTransport adds the headers the provider sends with every
+// request. Preview headers are appended to any value already set on the
+// request, extra headers replace it, and the API version is only sent to the
+// REST API.
+type headerInjectorTransport struct {
 	rt             http.RoundTripper
 	previewHeaders map[string]string
+	extraHeaders   map[string]string
+	apiVersion     string
 }
 
-func newPreviewHeaderInjectorTransport(headers map[string]string, rt http.RoundTripper) *previewHeaderInjectorTransport {
-	return &previewHeaderInjectorTransport{
+func newHeaderInjectorTransport(previewHeaders, extraHeaders map[string]string, apiVersion string, rt http.RoundTripper) *headerInjectorTransport {
+	return &headerInjectorTransport{
 		rt:             rt,
-		previewHeaders: headers,
+		previewHeaders: previewHeaders,
+		extraHeaders:   extraHeaders,
+		apiVersion:     apiVersion,
 	}
 }
 
-func (injector *previewHeaderInjectorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+func (injector *headerInjectorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	for name, value := range injector.previewHeaders {
 		header := req.Header.Get(name)
 		if header == "" {
@@ -187,5 +394,11 @@ func (injector *previewHeaderInjectorTransport) RoundTrip(req *http.Request) (*h
 		}
 		req.Header.Set(name, header)
 	}
+	for name, value := range injector.extraHeaders {
+		req.Header.Set(name, value)
+	}
+	if injector.apiVersion != "" && !isGraphQLRequest(req) {
+		req.Header.Set("X-GitHub-Api-Version", injector.apiVersion)
+	}
 	return injector.rt.RoundTrip(req)
 }
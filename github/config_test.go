@@ -2,6 +2,11 @@ package github
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
 
 	"github.com/shurcooL/githubv4"
@@ -137,3 +142,123 @@ func TestAccConfigMeta(t *testing.T) {
 	})
 
 }
+
+func TestConfigTransport(t *testing.T) {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			w.Header().Set("X-Client-Cert", r.TLS.PeerCertificates[0].Subject.CommonName)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	ts.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	ts.StartTLS()
+	defer ts.Close()
+
+	clientCertPEM, _ := os.ReadFile("test-fixtures/cert.pem")
+	clientKeyPEM, _ := os.ReadFile("test-fixtures/key.pem")
+
+	caCertPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+
+	get := func(config Config) error {
+		client := &http.Client{Transport: config.Transport()}
+		resp, err := client.Get(ts.URL)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	t.Run("rejects an untrusted certificate by default", func(t *testing.T) {
+		if err := get(Config{}); err == nil {
+			t.Fatal("Expected a certificate verification error, got nil")
+		}
+	})
+
+	t.Run("trusts a configured certificate authority", func(t *testing.T) {
+		tlsConfig, err := NewTLSConfig(caCertPEM, "", "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if err := get(Config{TLSConfig: tlsConfig}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	})
+
+	t.Run("skips verification when insecure", func(t *testing.T) {
+		if err := get(Config{Insecure: true}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	})
+
+	t.Run("presents a client certificate", func(t *testing.T) {
+		tlsConfig, err := NewTLSConfig(caCertPEM, string(clientCertPEM), string(clientKeyPEM))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		config := Config{TLSConfig: tlsConfig}
+		client := &http.Client{Transport: config.Transport()}
+		resp, err := client.Get(ts.URL)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.Header.Get("X-Client-Cert") == "" {
+			t.Fatal("Expected the server to receive a client certificate")
+		}
+	})
+
+	t.Run("rejects invalid certificate data", func(t *testing.T) {
+		if _, err := NewTLSConfig("not a certificate", "", ""); err == nil {
+			t.Fatal("Expected an error, got nil")
+		}
+		if _, err := NewTLSConfig("", string(clientCertPEM), ""); err == nil {
+			t.Fatal("Expected an error, got nil")
+		}
+	})
+}
+
+func TestHeaderInjectorTransport(t *testing.T) {
+	received := make(map[string]http.Header)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received[r.URL.Path] = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	config := Config{
+		BaseURL:      ts.URL + "/",
+		APIVersion:   "2022-11-28",
+		ExtraHeaders: map[string]string{"X-Gateway-Route": "ghes-primary"},
+	}
+	client := config.AnonymousHTTPClient()
+
+	for _, path := range []string{"/api/v3/user", "/api/graphql"} {
+		resp, err := client.Get(ts.URL + path)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	rest := received["/api/v3/user"]
+	if got := rest.Get("X-GitHub-Api-Version"); got != "2022-11-28" {
+		t.Fatalf("Expected the API version on REST calls, got: %q", got)
+	}
+	if got := rest.Get("X-Gateway-Route"); got != "ghes-primary" {
+		t.Fatalf("Expected the extra header on REST calls, got: %q", got)
+	}
+	if got := rest.Get("Accept"); got != "application/vnd.github.stone-crop-preview+json" {
+		t.Fatalf("Expected the preview Accept header, got: %q", got)
+	}
+
+	graphql := received["/api/graphql"]
+	if got := graphql.Get("X-GitHub-Api-Version"); got != "" {
+		t.Fatalf("Expected no API version on GraphQL calls, got: %q", got)
+	}
+	if got := graphql.Get("X-Gateway-Route"); got != "ghes-primary" {
+		t.Fatalf("Expected the extra header on GraphQL calls, got: %q", got)
+	}
+}
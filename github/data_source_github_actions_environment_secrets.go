@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -55,6 +54,7 @@ func dataSourceGithubActionsEnvironmentSecrets() *schema.Resource {
 }
 
 func dataSourceGithubActionsEnvironmentSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	var repoName string
@@ -77,7 +77,7 @@ func dataSourceGithubActionsEnvironmentSecretsRead(d *schema.ResourceData, meta
 		return fmt.Errorf("one of %q or %q has to be provided", "full_name", "name")
 	}
 
-	repo, _, err := client.Repositories.Get(context.TODO(), owner, repoName)
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
 	if err != nil {
 		return err
 	}
@@ -88,7 +88,7 @@ func dataSourceGithubActionsEnvironmentSecretsRead(d *schema.ResourceData, meta
 
 	var all_secrets []map[string]string
 	for {
-		secrets, resp, err := client.Actions.ListEnvSecrets(context.TODO(), int(repo.GetID()), env, &options)
+		secrets, resp, err := client.Actions.ListEnvSecrets(ctx, int(repo.GetID()), env, &options)
 		if err != nil {
 			return err
 		}
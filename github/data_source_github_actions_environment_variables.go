@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -59,6 +58,7 @@ func dataSourceGithubActionsEnvironmentVariables() *schema.Resource {
 }
 
 func dataSourceGithubActionsEnvironmentVariablesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	var repoName string
@@ -81,7 +81,7 @@ func dataSourceGithubActionsEnvironmentVariablesRead(d *schema.ResourceData, met
 		return fmt.Errorf("one of %q or %q has to be provided", "full_name", "name")
 	}
 
-	repo, _, err := client.Repositories.Get(context.TODO(), owner, repoName)
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
 	if err != nil {
 		return err
 	}
@@ -92,7 +92,7 @@ func dataSourceGithubActionsEnvironmentVariablesRead(d *schema.ResourceData, met
 
 	var all_variables []map[string]string
 	for {
-		variables, resp, err := client.Actions.ListEnvVariables(context.TODO(), int(repo.GetID()), env, &options)
+		variables, resp, err := client.Actions.ListEnvVariables(ctx, int(repo.GetID()), env, &options)
 		if err != nil {
 			return err
 		}
@@ -21,10 +21,10 @@ func dataSourceGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplate()
 }
 
 func dataSourceGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := meta.(*Owner).StopContext
 
 	err := checkOrganization(meta)
 	if err != nil {
@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -24,6 +22,8 @@ func dataSourceGithubActionsOrganizationPublicKey() *schema.Resource {
 }
 
 func dataSourceGithubActionsOrganizationPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -32,8 +32,6 @@ func dataSourceGithubActionsOrganizationPublicKeyRead(d *schema.ResourceData, me
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
-	ctx := context.Background()
-
 	publicKey, _, err := client.Actions.GetOrgPublicKey(ctx, owner)
 	if err != nil {
 		return err
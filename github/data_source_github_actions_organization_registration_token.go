@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 	"log"
 
@@ -26,11 +25,12 @@ func dataSourceGithubActionsOrganizationRegistrationToken() *schema.Resource {
 }
 
 func dataSourceGithubActionsOrganizationRegistrationTokenRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
 	log.Printf("[DEBUG] Creating a GitHub Actions organization registration token for %s", owner)
-	token, _, err := client.Actions.CreateOrganizationRegistrationToken(context.TODO(), owner)
+	token, _, err := client.Actions.CreateOrganizationRegistrationToken(ctx, owner)
 	if err != nil {
 		return fmt.Errorf("error creating a GitHub Actions organization registration token for %s: %s", owner, err)
 	}
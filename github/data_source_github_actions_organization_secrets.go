@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/google/go-github/v53/github"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -42,6 +40,7 @@ func dataSourceGithubActionsOrganizationSecrets() *schema.Resource {
 }
 
 func dataSourceGithubActionsOrganizationSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
@@ -51,7 +50,7 @@ func dataSourceGithubActionsOrganizationSecretsRead(d *schema.ResourceData, meta
 
 	var all_secrets []map[string]string
 	for {
-		secrets, resp, err := client.Actions.ListOrgSecrets(context.TODO(), owner, &options)
+		secrets, resp, err := client.Actions.ListOrgSecrets(ctx, owner, &options)
 		if err != nil {
 			return err
 		}
@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/google/go-github/v53/github"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -46,6 +44,7 @@ func dataSourceGithubActionsOrganizationVariables() *schema.Resource {
 }
 
 func dataSourceGithubActionsOrganizationVariablesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
@@ -55,7 +54,7 @@ func dataSourceGithubActionsOrganizationVariablesRead(d *schema.ResourceData, me
 
 	var all_variables []map[string]string
 	for {
-		variables, resp, err := client.Actions.ListOrgVariables(context.TODO(), owner, &options)
+		variables, resp, err := client.Actions.ListOrgVariables(ctx, owner, &options)
 		if err != nil {
 			return err
 		}
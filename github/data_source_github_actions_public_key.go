@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -28,11 +26,12 @@ func dataSourceGithubActionsPublicKey() *schema.Resource {
 }
 
 func dataSourceGithubActionsPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	repository := d.Get("repository").(string)
 	owner := meta.(*Owner).name
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	publicKey, _, err := client.Actions.GetRepoPublicKey(ctx, owner, repository)
 	if err != nil {
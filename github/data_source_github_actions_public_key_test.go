@@ -10,7 +10,12 @@ import (
 
 func TestAccGithubActionsPublicKeyDataSource(t *testing.T) {
 
-	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	// The committed cassette of this test was recorded against the in-process
+	// fake server of the fakegithub package, not against GitHub. It proves
+	// that the recorder replays a test without network access or credentials,
+	// not that it matches the traffic of github.com.
+	random := testAccUseCassette(t)
+	randomID := testAccRandString(random, 5, acctest.CharSetAlphaNum)
 
 	t.Run("queries a repository public key without error", func(t *testing.T) {
 
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 	"log"
 
@@ -31,12 +30,13 @@ func dataSourceGithubActionsRegistrationToken() *schema.Resource {
 }
 
 func dataSourceGithubActionsRegistrationTokenRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
 
 	log.Printf("[DEBUG] Creating a GitHub Actions repository registration token for %s/%s", owner, repoName)
-	token, _, err := client.Actions.CreateRegistrationToken(context.TODO(), owner, repoName)
+	token, _, err := client.Actions.CreateRegistrationToken(ctx, owner, repoName)
 	if err != nil {
 		return fmt.Errorf("error creating a GitHub Actions repository registration token for %s/%s: %s", owner, repoName, err)
 	}
@@ -27,11 +27,12 @@ func dataSourceGithubActionsRepositoryOIDCSubjectClaimCustomizationTemplate() *s
 }
 
 func dataSourceGithubActionsRepositoryOIDCSubjectClaimCustomizationTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
 
 	repository := d.Get("name").(string)
 	owner := meta.(*Owner).name
-	ctx := meta.(*Owner).StopContext
 
 	template, _, err := client.Actions.GetRepoOIDCSubjectClaimCustomTemplate(ctx, owner, repository)
 
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -51,6 +50,7 @@ func dataSourceGithubActionsSecrets() *schema.Resource {
 }
 
 func dataSourceGithubActionsSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	var repoName string
@@ -77,7 +77,7 @@ func dataSourceGithubActionsSecretsRead(d *schema.ResourceData, meta interface{}
 
 	var all_secrets []map[string]string
 	for {
-		secrets, resp, err := client.Actions.ListRepoSecrets(context.TODO(), owner, repoName, &options)
+		secrets, resp, err := client.Actions.ListRepoSecrets(ctx, owner, repoName, &options)
 		if err != nil {
 			return err
 		}
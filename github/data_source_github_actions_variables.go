@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -55,6 +54,7 @@ func dataSourceGithubActionsVariables() *schema.Resource {
 }
 
 func dataSourceGithubActionsVariablesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	var repoName string
@@ -81,7 +81,7 @@ func dataSourceGithubActionsVariablesRead(d *schema.ResourceData, meta interface
 
 	var all_variables []map[string]string
 	for {
-		variables, resp, err := client.Actions.ListRepoVariables(context.TODO(), owner, repoName, &options)
+		variables, resp, err := client.Actions.ListRepoVariables(ctx, owner, repoName, &options)
 		if err != nil {
 			return err
 		}
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -33,10 +32,11 @@ func dataSourceGithubApp() *schema.Resource {
 }
 
 func dataSourceGithubAppRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	slug := d.Get("slug").(string)
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	app, _, err := client.Apps.Get(ctx, slug)
 	if err != nil {
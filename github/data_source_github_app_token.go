@@ -42,6 +42,7 @@ func dataSourceGithubAppTokenRead(d *schema.ResourceData, meta interface{}) erro
 	pemFile := d.Get("pem_file").(string)
 
 	baseURL := meta.(*Owner).v3client.BaseURL.String()
+	client := meta.(*Owner).httpClient
 
 	// The Go encoding/pem package only decodes PEM formatted blocks
 	// that contain new lines. Some platforms, like Terraform Cloud,
@@ -52,7 +53,7 @@ func dataSourceGithubAppTokenRead(d *schema.ResourceData, meta interface{}) erro
 	// actual new line character before decoding.
 	pemFile = strings.Replace(pemFile, `\n`, "\n", -1)
 
-	token, err := GenerateOAuthTokenFromApp(baseURL, appID, installationID, pemFile)
+	token, err := GenerateOAuthTokenFromApp(client, baseURL, appID, installationID, pemFile)
 	if err != nil {
 		return err
 	}
@@ -41,8 +41,9 @@ func TestAccGithubAppTokenDataSource(t *testing.T) {
 		client.BaseURL = u
 
 		meta := &Owner{
-			name:     owner,
-			v3client: client,
+			name:       owner,
+			v3client:   client,
+			httpClient: httpCl,
 		}
 
 		testSchema := map[string]*schema.Schema{
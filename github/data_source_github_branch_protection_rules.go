@@ -31,6 +31,8 @@ func dataSourceGithubBranchProtectionRules() *schema.Resource {
 }
 
 func dataSourceGithubBranchProtectionRulesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v4client
 	orgName := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
@@ -55,7 +57,7 @@ func dataSourceGithubBranchProtectionRulesRead(d *schema.ResourceData, meta inte
 
 	var rules []interface{}
 	for {
-		err := client.Query(meta.(*Owner).StopContext, &query, variables)
+		err := client.Query(ctx, &query, variables)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,85 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceGithubBranchRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubBranchRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"branch": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parameters": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ruleset_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ruleset_source_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ruleset_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubBranchRulesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
+	repository := d.Get("repository").(string)
+	branch := d.Get("branch").(string)
+	owner := meta.(*Owner).name
+
+	client := meta.(*Owner).v3client
+
+	rules, err := listBranchRules(ctx, client, owner, repository, branch)
+	if err != nil {
+		return err
+	}
+
+	results := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		results = append(results, map[string]interface{}{
+			"type":                rule.Type,
+			"parameters":          string(rule.Parameters),
+			"ruleset_id":          int(rule.RulesetID),
+			"ruleset_source_type": rule.RulesetSourceType,
+			"ruleset_source":      rule.RulesetSource,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repository, branch))
+	d.Set("repository", repository)
+	d.Set("branch", branch)
+	d.Set("rules", results)
+
+	return nil
+}
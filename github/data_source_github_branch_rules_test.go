@@ -0,0 +1,83 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGithubBranchRulesDataSource(t *testing.T) {
+
+	t.Run("queries the rules applying to a branch", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%[1]s"
+				auto_init = true
+			}
+
+			resource "github_repository_ruleset" "test" {
+				repository  = github_repository.test.name
+				name        = "tf-acc-test-%[1]s"
+				target      = "branch"
+				enforcement = "active"
+
+				conditions {
+					ref_name {
+						include = ["~DEFAULT_BRANCH"]
+						exclude = []
+					}
+				}
+
+				rules {
+					deletion = true
+				}
+			}
+		`, randomID)
+
+		config2 := config + `
+			data "github_branch_rules" "test" {
+				repository = github_repository.test.name
+				branch     = "main"
+			}
+		`
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("data.github_branch_rules.test", "rules.#", "1"),
+			resource.TestCheckResourceAttr("data.github_branch_rules.test", "rules.0.type", "deletion"),
+			resource.TestCheckResourceAttrPair("data.github_branch_rules.test", "rules.0.ruleset_id", "github_repository_ruleset.test", "ruleset_id"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+					},
+					{
+						Config: config2,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -29,12 +28,13 @@ func dataSourceGithubCodespacesPublicKey() *schema.Resource {
 }
 
 func dataSourceGithubCodespacesPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	repository := d.Get("repository").(string)
 	owner := meta.(*Owner).name
 	log.Printf("[INFO] Refreshing GitHub Codespaces Public Key from: %s/%s", owner, repository)
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	publicKey, _, err := client.Codespaces.GetRepoPublicKey(ctx, owner, repository)
 	if err != nil {
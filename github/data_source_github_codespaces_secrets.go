@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -53,9 +52,10 @@ func dataSourceGithubCodespacesSecrets() *schema.Resource {
 }
 
 func dataSourceGithubCodespacesSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	var repoName string
 
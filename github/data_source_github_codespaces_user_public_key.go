@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -24,9 +22,9 @@ func dataSourceGithubCodespacesUserPublicKey() *schema.Resource {
 }
 
 func dataSourceGithubCodespacesUserPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*Owner).v3client
+	ctx := stopContext(meta)
 
-	ctx := context.Background()
+	client := meta.(*Owner).v3client
 
 	publicKey, _, err := client.Codespaces.GetUserPublicKey(ctx)
 	if err != nil {
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -109,9 +108,9 @@ func dataSourceGithubCollaborators() *schema.Resource {
 }
 
 func dataSourceGithubCollaboratorsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	owner := d.Get("owner").(string)
 	repo := d.Get("repository").(string)
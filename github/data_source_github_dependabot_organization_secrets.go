@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/google/go-github/v53/github"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -42,6 +40,7 @@ func dataSourceGithubDependabotOrganizationSecrets() *schema.Resource {
 }
 
 func dataSourceGithubDependabotOrganizationSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
@@ -51,7 +50,7 @@ func dataSourceGithubDependabotOrganizationSecretsRead(d *schema.ResourceData, m
 
 	var all_secrets []map[string]string
 	for {
-		secrets, resp, err := client.Dependabot.ListOrgSecrets(context.TODO(), owner, &options)
+		secrets, resp, err := client.Dependabot.ListOrgSecrets(ctx, owner, &options)
 		if err != nil {
 			return err
 		}
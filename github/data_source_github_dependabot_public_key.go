@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -29,12 +28,13 @@ func dataSourceGithubDependabotPublicKey() *schema.Resource {
 }
 
 func dataSourceGithubDependabotPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	repository := d.Get("repository").(string)
 	owner := meta.(*Owner).name
 	log.Printf("[INFO] Refreshing GitHub Dependabot Public Key from: %s/%s", owner, repository)
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	publicKey, _, err := client.Dependabot.GetRepoPublicKey(ctx, owner, repository)
 	if err != nil {
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -51,6 +50,7 @@ func dataSourceGithubDependabotSecrets() *schema.Resource {
 }
 
 func dataSourceGithubDependabotSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	var repoName string
@@ -77,7 +77,7 @@ func dataSourceGithubDependabotSecretsRead(d *schema.ResourceData, meta interfac
 
 	var all_secrets []map[string]string
 	for {
-		secrets, resp, err := client.Dependabot.ListRepoSecrets(context.TODO(), owner, repoName, &options)
+		secrets, resp, err := client.Dependabot.ListRepoSecrets(ctx, owner, repoName, &options)
 		if err != nil {
 			return err
 		}
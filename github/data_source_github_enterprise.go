@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/shurcooL/githubv4"
@@ -36,6 +35,8 @@ func dataSourceGithubEnterprise() *schema.Resource {
 }
 
 func dataSourceGithubEnterpriseRead(data *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	var query struct {
 		Enterprise struct {
 			ID          githubv4.String
@@ -51,7 +52,7 @@ func dataSourceGithubEnterpriseRead(data *schema.ResourceData, meta interface{})
 	variables := map[string]interface{}{
 		"slug": githubv4.String(slug),
 	}
-	err := client.Query(context.Background(), &query, variables)
+	err := client.Query(ctx, &query, variables)
 	if err != nil {
 		return err
 	}
@@ -38,6 +38,8 @@ func dataSourceGithubExternalGroups() *schema.Resource {
 }
 
 func dataSourceGithubExternalGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -45,7 +47,7 @@ func dataSourceGithubExternalGroupsRead(d *schema.ResourceData, meta interface{}
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	opts := &github.ListExternalGroupsOptions{}
 
 	externalGroups := new(github.ExternalGroupList)
@@ -137,9 +137,11 @@ func dataSourceGithubIpRanges() *schema.Resource {
 }
 
 func dataSourceGithubIpRangesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	owner := meta.(*Owner)
 
-	api, _, err := owner.v3client.APIMeta(owner.StopContext)
+	api, _, err := owner.v3client.APIMeta(ctx)
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -46,11 +45,12 @@ func dataSourceGithubIssueLabels() *schema.Resource {
 }
 
 func dataSourceGithubIssueLabelsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	repository := d.Get("repository").(string)
 
-	ctx := context.Background()
 	opts := &github.ListOptions{
 		PerPage: maxPerPage,
 	}
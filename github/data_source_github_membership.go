@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -36,6 +34,8 @@ func dataSourceGithubMembership() *schema.Resource {
 }
 
 func dataSourceGithubMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	username := d.Get("username").(string)
 
 	client := meta.(*Owner).v3client
@@ -45,8 +45,6 @@ func dataSourceGithubMembershipRead(d *schema.ResourceData, meta interface{}) er
 		orgName = configuredOrg
 	}
 
-	ctx := context.Background()
-
 	membership, resp, err := client.Organizations.GetOrgMembership(ctx,
 		username, orgName)
 
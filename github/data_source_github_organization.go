@@ -67,11 +67,12 @@ func dataSourceGithubOrganization() *schema.Resource {
 }
 
 func dataSourceGithubOrganizationRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	name := d.Get("name").(string)
 
 	client4 := meta.(*Owner).v4client
 	client3 := meta.(*Owner).v3client
-	ctx := meta.(*Owner).StopContext
 
 	organization, _, err := client3.Organizations.Get(ctx, name)
 	if err != nil {
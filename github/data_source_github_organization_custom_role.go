@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 	"log"
 
@@ -37,8 +36,9 @@ func dataSourceGithubOrganizationCustomRole() *schema.Resource {
 }
 
 func dataSourceGithubOrganizationCustomRoleRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 	orgName := meta.(*Owner).name
 
 	err := checkOrganization(meta)
@@ -41,10 +41,11 @@ func dataSourceGithubOrganizationExternalIdentities() *schema.Resource {
 }
 
 func dataSourceGithubOrganizationExternalIdentitiesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	name := meta.(*Owner).name
 
 	client4 := meta.(*Owner).v4client
-	ctx := meta.(*Owner).StopContext
 
 	var query struct {
 		Organization struct {
@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/shurcooL/githubv4"
 )
@@ -49,12 +47,13 @@ func dataSourceGithubOrganizationIpAllowList() *schema.Resource {
 }
 
 func dataSourceGithubOrganizationIpAllowListRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	client := meta.(*Owner).v4client
 	orgName := meta.(*Owner).name
 
@@ -0,0 +1,48 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceGithubOrganizationRuleSuites() *schema.Resource {
+	s := ruleSuitesDataSourceSchema()
+	s["repository_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return the rule suites of pushes to this repository.",
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceGithubOrganizationRuleSuitesRead,
+		Schema: s,
+	}
+}
+
+func dataSourceGithubOrganizationRuleSuitesRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx := stopContext(meta)
+
+	orgName := meta.(*Owner).name
+	client := meta.(*Owner).v3client
+
+	query := ruleSuitesQuery(d)
+	if repositoryName := d.Get("repository_name").(string); repositoryName != "" {
+		query.Set("repository_name", repositoryName)
+	}
+
+	suites, err := listRuleSuites(ctx, client, fmt.Sprintf("orgs/%s/rulesets/rule-suites", orgName), query)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(orgName)
+	d.Set("rule_suites", flattenRuleSuites(suites))
+
+	return nil
+}
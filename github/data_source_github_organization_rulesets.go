@@ -0,0 +1,39 @@
+package github
+
+import (
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceGithubOrganizationRulesets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationRulesetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"rulesets": rulesetsDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceGithubOrganizationRulesetsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx := stopContext(meta)
+
+	orgName := meta.(*Owner).name
+	client := meta.(*Owner).v3client
+
+	rulesets, err := listRulesets(ctx, client, resourceGithubOrganizationRulesetPath(orgName, ""), url.Values{})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(orgName)
+	d.Set("rulesets", flattenRulesetsDataSource(rulesets))
+
+	return nil
+}
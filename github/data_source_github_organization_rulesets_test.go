@@ -0,0 +1,79 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGithubOrganizationRulesetsDataSource(t *testing.T) {
+
+	t.Run("queries the rulesets of an organization", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_organization_ruleset" "test" {
+				name        = "tf-acc-test-%s"
+				target      = "branch"
+				enforcement = "evaluate"
+
+				conditions {
+					ref_name {
+						include = ["~DEFAULT_BRANCH"]
+						exclude = []
+					}
+
+					repository_name {
+						include = ["~ALL"]
+						exclude = []
+					}
+				}
+
+				rules {
+					deletion = true
+				}
+			}
+		`, randomID)
+
+		config2 := config + `
+			data "github_organization_rulesets" "test" {}
+		`
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet("data.github_organization_rulesets.test", "rulesets.#"),
+			resource.TestCheckResourceAttrSet("data.github_organization_rulesets.test", "rulesets.0.ruleset_id"),
+			resource.TestCheckResourceAttr("data.github_organization_rulesets.test", "rulesets.0.source_type", "Organization"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+					},
+					{
+						Config: config2,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -38,8 +37,9 @@ func dataSourceGithubOrganizationTeamSyncGroups() *schema.Resource {
 }
 
 func dataSourceGithubOrganizationTeamSyncGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	orgName := meta.(*Owner).name
 	options := &github.ListCursorOptions{PerPage: maxPerPage}
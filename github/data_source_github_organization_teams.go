@@ -79,6 +79,8 @@ func dataSourceGithubOrganizationTeams() *schema.Resource {
 }
 
 func dataSourceGithubOrganizationTeamsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -102,7 +104,7 @@ func dataSourceGithubOrganizationTeamsRead(d *schema.ResourceData, meta interfac
 
 	var teams []interface{}
 	for {
-		err = client.Query(meta.(*Owner).StopContext, &query, variables)
+		err = client.Query(ctx, &query, variables)
 		if err != nil {
 			return err
 		}
@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -45,10 +43,11 @@ func dataSourceGithubOrganizationWebhooks() *schema.Resource {
 }
 
 func dataSourceGithubOrganizationWebhooksRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	owner := meta.(*Owner).name
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	options := &github.ListOptions{
 		PerPage: 100,
@@ -0,0 +1,233 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func dataSourceGithubProjectV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubProjectV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"number": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The number of the project within its owner.",
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"short_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"readme": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"closed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"color": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"iterations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"start_date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"duration": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"views": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"layout": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type projectV2DataSourceProject struct {
+	ID               githubv4.ID
+	Title            githubv4.String
+	ShortDescription githubv4.String
+	Readme           githubv4.String
+	Public           githubv4.Boolean
+	Closed           githubv4.Boolean
+	URL              githubv4.URI
+	Fields           struct {
+		Nodes []projectV2FieldConfiguration
+	} `graphql:"fields(first:100)"`
+	Views struct {
+		Nodes []struct {
+			ID     githubv4.ID
+			Name   githubv4.String
+			Number githubv4.Int
+			Layout githubv4.String
+		}
+	} `graphql:"views(first:100)"`
+}
+
+func dataSourceGithubProjectV2Read(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
+	client := meta.(*Owner).v4client
+	owner := meta.(*Owner).name
+	number := d.Get("number").(int)
+
+	variables := map[string]interface{}{
+		"login":  githubv4.String(owner),
+		"number": githubv4.Int(number),
+	}
+
+	var project projectV2DataSourceProject
+	if meta.(*Owner).IsOrganization {
+		var query struct {
+			Organization struct {
+				ProjectV2 projectV2DataSourceProject `graphql:"projectV2(number:$number)"`
+			} `graphql:"organization(login:$login)"`
+		}
+		if err := client.Query(ctx, &query, variables); err != nil {
+			return err
+		}
+		project = query.Organization.ProjectV2
+	} else {
+		var query struct {
+			User struct {
+				ProjectV2 projectV2DataSourceProject `graphql:"projectV2(number:$number)"`
+			} `graphql:"user(login:$login)"`
+		}
+		if err := client.Query(ctx, &query, variables); err != nil {
+			return err
+		}
+		project = query.User.ProjectV2
+	}
+
+	if project.ID == nil {
+		return fmt.Errorf("project %d of %s not found", number, owner)
+	}
+
+	fields := make([]interface{}, 0, len(project.Fields.Nodes))
+	for _, f := range project.Fields.Nodes {
+		common := f.common()
+		fields = append(fields, map[string]interface{}{
+			"id":         fmt.Sprintf("%s", common.ID),
+			"name":       string(common.Name),
+			"data_type":  string(common.DataType),
+			"options":    f.flattenOptions(),
+			"iterations": f.flattenIterations(),
+		})
+	}
+
+	views := make([]interface{}, 0, len(project.Views.Nodes))
+	for _, v := range project.Views.Nodes {
+		views = append(views, map[string]interface{}{
+			"id":     fmt.Sprintf("%s", v.ID),
+			"name":   string(v.Name),
+			"number": int(v.Number),
+			"layout": string(v.Layout),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s", project.ID))
+	d.Set("title", string(project.Title))
+	d.Set("short_description", string(project.ShortDescription))
+	d.Set("readme", string(project.Readme))
+	d.Set("public", bool(project.Public))
+	d.Set("closed", bool(project.Closed))
+	d.Set("url", project.URL.String())
+	d.Set("fields", fields)
+	d.Set("views", views)
+
+	return nil
+}
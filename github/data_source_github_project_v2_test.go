@@ -0,0 +1,74 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGithubProjectV2DataSource(t *testing.T) {
+
+	t.Run("queries a project and its fields", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_project_v2" "test" {
+				title = "tf-acc-test-%s"
+			}
+
+			resource "github_project_v2_field" "test" {
+				project_id = github_project_v2.test.id
+				name       = "Priority"
+				data_type  = "SINGLE_SELECT"
+
+				option {
+					name = "High"
+				}
+			}
+		`, randomID)
+
+		config2 := config + `
+			data "github_project_v2" "test" {
+				number = github_project_v2.test.number
+			}
+		`
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrPair("data.github_project_v2.test", "id", "github_project_v2.test", "id"),
+			resource.TestCheckResourceAttrPair("data.github_project_v2.test", "title", "github_project_v2.test", "title"),
+			resource.TestCheckResourceAttrSet("data.github_project_v2.test", "fields.#"),
+			resource.TestCheckResourceAttrSet("data.github_project_v2.test", "views.0.id"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+					},
+					{
+						Config: config2,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"log"
 	"net/http"
 
@@ -41,6 +40,7 @@ func dataSourceGithubRef() *schema.Resource {
 }
 
 func dataSourceGithubRefRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner, ok := d.Get("owner").(string)
 	if !ok {
@@ -49,7 +49,7 @@ func dataSourceGithubRefRead(d *schema.ResourceData, meta interface{}) error {
 	repoName := d.Get("repository").(string)
 	ref := d.Get("ref").(string)
 
-	refData, resp, err := client.Git.GetRef(context.TODO(), owner, repoName, ref)
+	refData, resp, err := client.Git.GetRef(ctx, owner, repoName, ref)
 	if err != nil {
 		if err, ok := err.(*github.ErrorResponse); ok {
 			if err.Response.StatusCode == http.StatusNotFound {
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -152,11 +151,12 @@ func dataSourceGithubRelease() *schema.Resource {
 }
 
 func dataSourceGithubReleaseRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	repository := d.Get("repository").(string)
 	owner := d.Get("owner").(string)
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	var err error
 	var release *github.RepositoryRelease
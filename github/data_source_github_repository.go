@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -212,11 +211,18 @@ func dataSourceGithubRepository() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"custom_properties": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The custom property values of the repository, with the values of multi_select properties joined by commas.",
+			},
 		},
 	}
 }
 
 func dataSourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	var repoName string
@@ -236,7 +242,7 @@ func dataSourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("one of %q or %q has to be provided", "full_name", "name")
 	}
 
-	repo, _, err := client.Repositories.Get(context.TODO(), owner, repoName)
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
 	if err != nil {
 		if err, ok := err.(*github.ErrorResponse); ok {
 			if err.Response.StatusCode == http.StatusNotFound {
@@ -282,7 +288,7 @@ func dataSourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("has_projects", repo.GetHasProjects())
 
 	if repo.GetHasPages() {
-		pages, _, err := client.Repositories.GetPagesInfo(context.TODO(), owner, repoName)
+		pages, _, err := client.Repositories.GetPagesInfo(ctx, owner, repoName)
 		if err != nil {
 			return err
 		}
@@ -304,6 +310,23 @@ func dataSourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("template", []interface{}{})
 	}
 
+	customProperties := map[string]interface{}{}
+	if repo.GetOwner().GetType() == "Organization" && requireGHESVersion(meta, "3.13", "custom properties") == nil {
+		values, err := meta.(*Owner).repositoryCustomProperties(ctx, owner, repoName)
+		if err != nil {
+			if !customPropertiesUnavailable(err) {
+				return fmt.Errorf("error reading the custom properties of repository %s/%s: %s", owner, repoName, err)
+			}
+			log.Printf("[WARN] Unable to read the custom properties of repository %s/%s: %s", owner, repoName, err)
+		} else {
+			customProperties, err = flattenCustomPropertyValues(values)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	d.Set("custom_properties", customProperties)
+
 	err = d.Set("topics", flattenStringList(repo.Topics))
 	if err != nil {
 		return err
@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -41,6 +39,8 @@ func dataSourceGithubRepositoryAutolinkReferences() *schema.Resource {
 }
 
 func dataSourceGithubRepositoryAutolinkReferencesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
@@ -49,7 +49,7 @@ func dataSourceGithubRepositoryAutolinkReferencesRead(d *schema.ResourceData, me
 
 	var listOptions *github.ListOptions
 	for {
-		autoLinks, resp, err := client.Repositories.ListAutolinks(context.Background(), orgName, repoName, listOptions)
+		autoLinks, resp, err := client.Repositories.ListAutolinks(ctx, orgName, repoName, listOptions)
 		if err != nil {
 			return err
 		}
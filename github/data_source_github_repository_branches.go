@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -65,6 +64,7 @@ func flattenBranches(branches []*github.Branch) []map[string]interface{} {
 }
 
 func dataSourceGithubRepositoryBranchesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
@@ -86,7 +86,7 @@ func dataSourceGithubRepositoryBranchesRead(d *schema.ResourceData, meta interfa
 
 	results := make([]map[string]interface{}, 0)
 	for {
-		branches, resp, err := client.Repositories.ListBranches(context.TODO(), orgName, repoName, listBranchOptions)
+		branches, resp, err := client.Repositories.ListBranches(ctx, orgName, repoName, listBranchOptions)
 		if err != nil {
 			return err
 		}
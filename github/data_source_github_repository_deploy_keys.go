@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -46,11 +45,12 @@ func dataSourceGithubRepositoryDeployKeys() *schema.Resource {
 }
 
 func dataSourceGithubRepositoryDeployKeysRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	repository := d.Get("repository").(string)
 	owner := meta.(*Owner).name
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	options := &github.ListOptions{
 		PerPage: 100,
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -45,12 +44,14 @@ func dataSourceGithubRepositoryDeploymentBranchPolicies() *schema.Resource {
 }
 
 func dataSourceGithubRepositoryDeploymentBranchPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
 	environmentName := d.Get("environment_name").(string)
 
-	policies, _, err := client.Repositories.ListDeploymentBranchPolicies(context.Background(), owner, repoName, environmentName)
+	policies, _, err := client.Repositories.ListDeploymentBranchPolicies(ctx, owner, repoName, environmentName)
 	if err != nil {
 		return nil
 	}
@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -37,6 +35,8 @@ func dataSourceGithubRepositoryEnvironments() *schema.Resource {
 }
 
 func dataSourceGithubRepositoryEnvironmentsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
@@ -45,7 +45,7 @@ func dataSourceGithubRepositoryEnvironmentsRead(d *schema.ResourceData, meta int
 
 	var listOptions *github.EnvironmentListOptions
 	for {
-		environments, resp, err := client.Repositories.ListEnvironments(context.Background(), orgName, repoName, listOptions)
+		environments, resp, err := client.Repositories.ListEnvironments(ctx, orgName, repoName, listOptions)
 		if err != nil {
 			return err
 		}
@@ -71,8 +71,10 @@ func dataSourceGithubRepositoryFile() *schema.Resource {
 }
 
 func dataSourceGithubRepositoryFileRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	owner := meta.(*Owner).name
 	repo := d.Get("repository").(string)
@@ -130,7 +132,7 @@ func dataSourceGithubRepositoryFileRead(d *schema.ResourceData, meta interface{}
 	d.Set("ref", ref)
 
 	log.Printf("[DEBUG] Data Source fetching commit info for repository file: %s/%s/%s", owner, repo, file)
-	commit, err := getFileCommit(client, owner, repo, file, ref)
+	commit, err := getFileCommit(ctx, client, owner, repo, file, ref)
 	log.Printf("[DEBUG] Found file: %s/%s/%s, in commit SHA: %s ", owner, repo, file, commit.GetSHA())
 	if err != nil {
 		return err
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"strconv"
 )
@@ -44,8 +43,9 @@ func dataSourceGithubRepositoryMilestone() *schema.Resource {
 }
 
 func dataSourceGithubRepositoryMilestoneRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	conn := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	owner := d.Get("owner").(string)
 	repoName := d.Get("repository").(string)
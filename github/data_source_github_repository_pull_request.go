@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -91,7 +90,7 @@ func dataSourceGithubRepositoryPullRequest() *schema.Resource {
 }
 
 func dataSourceGithubRepositoryPullRequestRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.TODO()
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"strings"
 
 	"github.com/google/go-github/v53/github"
@@ -129,7 +128,7 @@ func dataSourceGithubRepositoryPullRequests() *schema.Resource {
 }
 
 func dataSourceGithubRepositoryPullRequestsRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.TODO()
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
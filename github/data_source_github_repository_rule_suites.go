@@ -0,0 +1,41 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceGithubRepositoryRuleSuites() *schema.Resource {
+	s := ruleSuitesDataSourceSchema()
+	s["repository"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceGithubRepositoryRuleSuitesRead,
+		Schema: s,
+	}
+}
+
+func dataSourceGithubRepositoryRuleSuitesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
+	repository := d.Get("repository").(string)
+	owner := meta.(*Owner).name
+
+	client := meta.(*Owner).v3client
+
+	path := fmt.Sprintf("repos/%s/%s/rulesets/rule-suites", owner, repository)
+	suites, err := listRuleSuites(ctx, client, path, ruleSuitesQuery(d))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repository))
+	d.Set("repository", repository)
+	d.Set("rule_suites", flattenRuleSuites(suites))
+
+	return nil
+}
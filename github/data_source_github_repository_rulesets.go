@@ -0,0 +1,52 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceGithubRepositoryRulesets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoryRulesetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"include_parents": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to include the rulesets of the organization which apply to the repository.",
+			},
+			"rulesets": rulesetsDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceGithubRepositoryRulesetsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
+	repository := d.Get("repository").(string)
+	owner := meta.(*Owner).name
+
+	client := meta.(*Owner).v3client
+
+	query := url.Values{}
+	query.Set("includes_parents", strconv.FormatBool(d.Get("include_parents").(bool)))
+
+	rulesets, err := listRulesets(ctx, client, resourceGithubRepositoryRulesetPath(owner, repository, ""), query)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repository))
+	d.Set("repository", repository)
+	d.Set("rulesets", flattenRulesetsDataSource(rulesets))
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGithubRepositoryRulesetsDataSource(t *testing.T) {
+
+	t.Run("queries the rulesets of a repository", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%[1]s"
+				auto_init = true
+			}
+
+			resource "github_repository_ruleset" "test" {
+				repository  = github_repository.test.name
+				name        = "tf-acc-test-%[1]s"
+				target      = "branch"
+				enforcement = "active"
+
+				rules {
+					deletion = true
+				}
+			}
+		`, randomID)
+
+		config2 := config + `
+			data "github_repository_rulesets" "test" {
+				repository      = github_repository.test.name
+				include_parents = false
+			}
+		`
+
+		check := resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr("data.github_repository_rulesets.test", "rulesets.#", "1"),
+			resource.TestCheckResourceAttrPair("data.github_repository_rulesets.test", "rulesets.0.ruleset_id", "github_repository_ruleset.test", "ruleset_id"),
+			resource.TestCheckResourceAttr("data.github_repository_rulesets.test", "rulesets.0.enforcement", "active"),
+			resource.TestCheckResourceAttr("data.github_repository_rulesets.test", "rulesets.0.source_type", "Repository"),
+		)
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+					},
+					{
+						Config: config2,
+						Check:  check,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
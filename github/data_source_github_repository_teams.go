@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v53/github"
@@ -51,6 +50,7 @@ func dataSourceGithubRepositoryTeams() *schema.Resource {
 }
 
 func dataSourceGithubTeamsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	var repoName string
@@ -77,7 +77,7 @@ func dataSourceGithubTeamsRead(d *schema.ResourceData, meta interface{}) error {
 
 	var all_teams []map[string]string
 	for {
-		teams, resp, err := client.Repositories.ListTeams(context.TODO(), owner, repoName, &options)
+		teams, resp, err := client.Repositories.ListTeams(ctx, owner, repoName, &options)
 		if err != nil {
 			return err
 		}
@@ -1,11 +1,25 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
 
+	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
+// restApiNextLink matches the URL of the next page in a Link header
+var restApiNextLink = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
 func dataSourceGithubRestApi() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceGithubRestApiRead,
@@ -16,6 +30,26 @@ func dataSourceGithubRestApi() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      http.MethodGet,
+				ValidateFunc: validation.StringInSlice([]string{http.MethodGet, http.MethodHead}, false),
+			},
+			"query": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"accept": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"paginate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"code": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -27,9 +61,10 @@ func dataSourceGithubRestApi() *schema.Resource {
 			"headers": {
 				Type:     schema.TypeMap,
 				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"body": {
-				Type:     schema.TypeMap,
+				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
@@ -37,25 +72,159 @@ func dataSourceGithubRestApi() *schema.Resource {
 }
 
 func dataSourceGithubRestApiRead(d *schema.ResourceData, meta interface{}) error {
-	u := d.Get("endpoint").(string)
+	ctx := stopContext(meta)
+
+	endpoint := d.Get("endpoint").(string)
+	method := d.Get("method").(string)
+	accept := d.Get("accept").(string)
+	paginate := d.Get("paginate").(bool)
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint %q: %s", endpoint, err)
+	}
+	q := u.Query()
+	for name, value := range d.Get("query").(map[string]interface{}) {
+		q.Set(name, value.(string))
+	}
+	if paginate && q.Get("per_page") == "" {
+		q.Set("per_page", fmt.Sprint(maxPerPage))
+	}
+	u.RawQuery = q.Encode()
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
-	var body map[string]interface{}
+	var pages [][]byte
+	var resp *github.Response
+	next := u.String()
+	for next != "" {
+		req, err := client.NewRequest(method, next, nil)
+		if err != nil {
+			return err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
 
-	req, err := client.NewRequest("GET", u, nil)
-	if err != nil {
-		return err
+		var body []byte
+		resp, body, err = restApiDo(ctx, client, req)
+		if err != nil {
+			return fmt.Errorf("error requesting %s %s: %s", method, next, err)
+		}
+		pages = append(pages, body)
+
+		next = ""
+		if paginate && resp.StatusCode < http.StatusMultipleChoices {
+			if match := restApiNextLink.FindStringSubmatch(resp.Header.Get("Link")); match != nil {
+				next = match[1]
+			}
+		}
 	}
 
-	resp, _ := client.Do(ctx, req, &body)
+	body := pages[0]
+	if paginate && len(pages) > 1 {
+		body, err = restApiMergePages(pages)
+		if err != nil {
+			return fmt.Errorf("unable to paginate %s: %s", endpoint, err)
+		}
+	}
 
-	d.SetId(resp.Header.Get("x-github-request-id"))
+	headers := make(map[string]string, len(resp.Header))
+	for name, values := range resp.Header {
+		headers[name] = strings.Join(values, ", ")
+	}
+
+	id := resp.Header.Get("x-github-request-id")
+	if id == "" {
+		id = endpoint
+	}
+	d.SetId(id)
 	d.Set("code", resp.StatusCode)
 	d.Set("status", resp.Status)
-	d.Set("headers", resp.Header)
-	d.Set("body", body)
+	d.Set("headers", headers)
+	d.Set("body", string(body))
 
 	return nil
 }
+
+// restApiDo sends a request and returns its response along with the raw body.
+// Responses with an HTTP error status are returned without an error, so that
+// they can be inspected like any other, except for rate limits which are
+// not an answer to the request.
+func restApiDo(ctx context.Context, client *github.Client, req *http.Request) (*github.Response, []byte, error) {
+	resp, err := client.BareDo(ctx, req)
+	if resp == nil {
+		return nil, nil, err
+	}
+	if err != nil {
+		var rateLimitErr *github.RateLimitError
+		var abuseRateLimitErr *github.AbuseRateLimitError
+		if errors.As(err, &rateLimitErr) || errors.As(err, &abuseRateLimitErr) {
+			return nil, nil, err
+		}
+		var acceptedErr *github.AcceptedError
+		if errors.As(err, &acceptedErr) {
+			return resp, acceptedErr.Raw, nil
+		}
+		var errorResponse *github.ErrorResponse
+		if !errors.As(err, &errorResponse) {
+			return nil, nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
+}
+
+// restApiMergePages combines the pages of a paginated response. Arrays are
+// concatenated. For objects wrapping an array, like the workflow runs of a
+// repository, the arrays are concatenated and the other fields are taken from
+// the first page.
+func restApiMergePages(pages [][]byte) ([]byte, error) {
+	var merged interface{}
+	for i, page := range pages {
+		var value interface{}
+		if err := json.Unmarshal(page, &value); err != nil {
+			return nil, fmt.Errorf("page %d is not JSON: %s", i+1, err)
+		}
+
+		switch v := value.(type) {
+		case []interface{}:
+			items, ok := merged.([]interface{})
+			if i > 0 && !ok {
+				return nil, fmt.Errorf("page %d is an array unlike the first page", i+1)
+			}
+			merged = append(items, v...)
+		case map[string]interface{}:
+			if i == 0 {
+				merged = v
+				continue
+			}
+			object, ok := merged.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("page %d is an object unlike the first page", i+1)
+			}
+			for key := range v {
+				items, isArray := v[key].([]interface{})
+				existing, wasArray := object[key].([]interface{})
+				if isArray && wasArray {
+					object[key] = append(existing, items...)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("page %d is neither an array nor an object", i+1)
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(merged); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -30,6 +30,9 @@ func TestAccGithubRestApiDataSource(t *testing.T) {
 			resource.TestMatchResourceAttr(
 				"data.github_rest_api.test", "code", regexp.MustCompile("200"),
 			),
+			resource.TestMatchResourceAttr(
+				"data.github_rest_api.test", "body", regexp.MustCompile(`"object":\s*\{`),
+			),
 		)
 
 		testCase := func(t *testing.T, mode string) {
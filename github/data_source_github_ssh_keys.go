@@ -17,9 +17,11 @@ func dataSourceGithubSshKeys() *schema.Resource {
 }
 
 func dataSourceGithubSshKeysRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	owner := meta.(*Owner)
 
-	api, _, err := owner.v3client.APIMeta(owner.StopContext)
+	api, _, err := owner.v3client.APIMeta(ctx)
 	if err != nil {
 		return err
 	}
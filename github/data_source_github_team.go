@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/google/go-github/v53/github"
@@ -89,11 +88,12 @@ func dataSourceGithubTeam() *schema.Resource {
 }
 
 func dataSourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	slug := d.Get("slug").(string)
 
 	client := meta.(*Owner).v3client
 	orgId := meta.(*Owner).id
-	ctx := context.Background()
 	summaryOnly := d.Get("summary_only").(bool)
 	resultsPerPage := d.Get("results_per_page").(int)
 
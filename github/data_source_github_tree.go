@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -56,13 +54,14 @@ func dataSourceGithubTree() *schema.Resource {
 }
 
 func dataSourceGithubTreeRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	owner := meta.(*Owner).name
 	repository := d.Get("repository").(string)
 	sha := d.Get("tree_sha").(string)
 	recursive := d.Get("recursive").(bool)
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	tree, _, err := client.Git.GetTree(ctx, owner, repository, sha, recursive)
 
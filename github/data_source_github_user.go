@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -103,10 +102,11 @@ func dataSourceGithubUser() *schema.Resource {
 }
 
 func dataSourceGithubUserRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	username := d.Get("username").(string)
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	user, _, err := client.Users.Get(ctx, username)
 	if err != nil {
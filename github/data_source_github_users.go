@@ -55,6 +55,8 @@ func dataSourceGithubUsers() *schema.Resource {
 }
 
 func dataSourceGithubUsersRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := stopContext(meta)
+
 	usernames := expandStringList(d.Get("usernames").([]interface{}))
 
 	// Create GraphQL variables and query struct
@@ -77,7 +79,7 @@ func dataSourceGithubUsersRead(d *schema.ResourceData, meta interface{}) error {
 	query := reflect.New(reflect.StructOf(fields)).Elem()
 
 	if len(usernames) > 0 {
-		ctx := context.WithValue(context.Background(), ctxId, d.Id())
+		ctx := context.WithValue(ctx, ctxId, d.Id())
 		client := meta.(*Owner).v4client
 		err := client.Query(ctx, query.Addr().Interface(), variables)
 		if err != nil && !strings.Contains(err.Error(), "Could not resolve to a User with the login of") {
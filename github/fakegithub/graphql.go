@@ -0,0 +1,587 @@
+package fakegithub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The server implements the subset of GraphQL the provider sends through
+// githubv4: operations made of fields with arguments, aliases and inline
+// fragments, resolved against the state of the server.
+
+// gqlSelection is a field or, when on is set, an inline fragment
+type gqlSelection struct {
+	alias      string
+	name       string
+	args       map[string]interface{}
+	on         string
+	selections []gqlSelection
+}
+
+func (sel gqlSelection) key() string {
+	if sel.alias != "" {
+		return sel.alias
+	}
+	return sel.name
+}
+
+// gqlObject is a GraphQL object, whose fields are resolved on demand
+type gqlObject struct {
+	typename string
+	fields   map[string]gqlResolver
+}
+
+type gqlResolver func(args map[string]interface{}) (interface{}, error)
+
+// gqlValue returns a resolver for a value known in advance
+func gqlValue(v interface{}) gqlResolver {
+	return func(map[string]interface{}) (interface{}, error) {
+		return v, nil
+	}
+}
+
+type gqlRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+func (s *Server) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	var req gqlRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Problems parsing JSON")
+		return
+	}
+
+	response := map[string]interface{}{}
+	data, err := s.executeGraphQL(req)
+	if err != nil {
+		response["errors"] = []interface{}{map[string]interface{}{"message": err.Error()}}
+	}
+	response["data"] = data
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (s *Server) executeGraphQL(req gqlRequest) (interface{}, error) {
+	operation, selections, err := parseGraphQL(req.Query, req.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	switch operation {
+	case "query":
+		return executeSelections(s.queryRoot(), selections)
+	case "mutation":
+		return executeSelections(s.mutationRoot(), selections)
+	default:
+		return nil, fmt.Errorf("%s operations are not supported", operation)
+	}
+}
+
+func executeSelections(object *gqlObject, selections []gqlSelection) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	for _, sel := range selections {
+		if sel.on != "" {
+			if sel.on != object.typename {
+				continue
+			}
+			fragment, err := executeSelections(object, sel.selections)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range fragment {
+				result[k] = v
+			}
+			continue
+		}
+
+		if sel.name == "__typename" {
+			result[sel.key()] = object.typename
+			continue
+		}
+
+		resolver, ok := object.fields[sel.name]
+		if !ok {
+			return nil, fmt.Errorf("Field '%s' doesn't exist on type '%s'", sel.name, object.typename)
+		}
+		value, err := resolver(sel.args)
+		if err != nil {
+			return nil, err
+		}
+		if result[sel.key()], err = executeValue(value, sel.selections); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func executeValue(value interface{}, selections []gqlSelection) (interface{}, error) {
+	switch v := value.(type) {
+	case *gqlObject:
+		if v == nil {
+			return nil, nil
+		}
+		return executeSelections(v, selections)
+	case []*gqlObject:
+		list := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			result, err := executeValue(item, selections)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, result)
+		}
+		return list, nil
+	}
+	return value, nil
+}
+
+// gqlConnection returns a connection holding all of its nodes in one page
+func gqlConnection(typename string, nodes []*gqlObject) *gqlObject {
+	return &gqlObject{
+		typename: typename,
+		fields: map[string]gqlResolver{
+			"nodes":      gqlValue(nodes),
+			"totalCount": gqlValue(len(nodes)),
+			"pageInfo": gqlValue(&gqlObject{
+				typename: "PageInfo",
+				fields: map[string]gqlResolver{
+					"hasNextPage":     gqlValue(false),
+					"hasPreviousPage": gqlValue(false),
+					"startCursor":     gqlValue(nil),
+					"endCursor":       gqlValue(nil),
+				},
+			}),
+		},
+	}
+}
+
+func (s *Server) queryRoot() *gqlObject {
+	return &gqlObject{
+		typename: "Query",
+		fields: map[string]gqlResolver{
+			"viewer": gqlValue(s.userObject(s.user)),
+			"user": func(args map[string]interface{}) (interface{}, error) {
+				user, ok := s.users[strings.ToLower(stringArg(args, "login"))]
+				if !ok {
+					return nil, fmt.Errorf("Could not resolve to a User with the login of '%s'.", stringArg(args, "login"))
+				}
+				return s.userObject(user), nil
+			},
+			"organization": func(args map[string]interface{}) (interface{}, error) {
+				org, ok := s.organizations[strings.ToLower(stringArg(args, "login"))]
+				if !ok {
+					return nil, fmt.Errorf("Could not resolve to an Organization with the login of '%s'.", stringArg(args, "login"))
+				}
+				return s.organizationObject(org), nil
+			},
+			"repository": func(args map[string]interface{}) (interface{}, error) {
+				r, ok := s.repositories[repositoryKey(stringArg(args, "owner"), stringArg(args, "name"))]
+				if !ok {
+					return nil, fmt.Errorf("Could not resolve to a Repository with the name '%s/%s'.", stringArg(args, "owner"), stringArg(args, "name"))
+				}
+				return s.repositoryObject(r), nil
+			},
+			"node": func(args map[string]interface{}) (interface{}, error) {
+				node := s.node(stringArg(args, "id"))
+				if node == nil {
+					return nil, notFoundNode(stringArg(args, "id"))
+				}
+				return node, nil
+			},
+		},
+	}
+}
+
+// node resolves a node ID to the object it identifies, or nil
+func (s *Server) node(id string) *gqlObject {
+	for _, user := range s.users {
+		if user.string("node_id") == id {
+			return s.userObject(user)
+		}
+	}
+	for _, org := range s.organizations {
+		if org.doc.string("node_id") == id {
+			return s.organizationObject(org)
+		}
+		for _, t := range org.teams {
+			if t.doc.string("node_id") == id {
+				return s.teamObject(t)
+			}
+		}
+	}
+	for _, r := range s.repositories {
+		if r.doc.string("node_id") == id {
+			return s.repositoryObject(r)
+		}
+	}
+	return s.projectNode(id)
+}
+
+func (s *Server) userObject(user document) *gqlObject {
+	return &gqlObject{
+		typename: "User",
+		fields: map[string]gqlResolver{
+			"id":         gqlValue(user.string("node_id")),
+			"databaseId": gqlValue(user.id()),
+			"login":      gqlValue(user.string("login")),
+			"projectV2":  s.projectV2Resolver(user.string("node_id")),
+		},
+	}
+}
+
+func (s *Server) organizationObject(org *organization) *gqlObject {
+	return &gqlObject{
+		typename: "Organization",
+		fields: map[string]gqlResolver{
+			"id":         gqlValue(org.doc.string("node_id")),
+			"databaseId": gqlValue(org.doc.id()),
+			"login":      gqlValue(org.doc.string("login")),
+			"projectV2":  s.projectV2Resolver(org.doc.string("node_id")),
+			"team": func(args map[string]interface{}) (interface{}, error) {
+				t, ok := org.teamBySlug(stringArg(args, "slug"))
+				if !ok {
+					return (*gqlObject)(nil), nil
+				}
+				return s.teamObject(t), nil
+			},
+			"teams": func(args map[string]interface{}) (interface{}, error) {
+				teams := make([]*gqlObject, 0, len(org.teams))
+				for _, t := range org.teams {
+					teams = append(teams, s.teamObject(t))
+				}
+				return gqlConnection("TeamConnection", teams), nil
+			},
+		},
+	}
+}
+
+func (s *Server) teamObject(t *team) *gqlObject {
+	return &gqlObject{
+		typename: "Team",
+		fields: map[string]gqlResolver{
+			"id":          gqlValue(t.doc.string("node_id")),
+			"databaseId":  gqlValue(t.doc.id()),
+			"name":        gqlValue(t.doc.string("name")),
+			"slug":        gqlValue(t.doc.string("slug")),
+			"description": gqlValue(t.doc.string("description")),
+			"members": func(args map[string]interface{}) (interface{}, error) {
+				members := make([]*gqlObject, 0, len(t.memberships))
+				for login := range t.memberships {
+					members = append(members, s.userObject(s.users[login]))
+				}
+				return gqlConnection("TeamMemberConnection", members), nil
+			},
+		},
+	}
+}
+
+func (s *Server) repositoryObject(r *repository) *gqlObject {
+	refObject := func(branch string) *gqlObject {
+		return &gqlObject{
+			typename: "Ref",
+			fields: map[string]gqlResolver{
+				"id":     gqlValue(s.ref(r, branch)["node_id"]),
+				"name":   gqlValue(branch),
+				"prefix": gqlValue("refs/heads/"),
+				"target": gqlValue(&gqlObject{
+					typename: "Commit",
+					fields: map[string]gqlResolver{
+						"oid": gqlValue(r.branches[branch]),
+					},
+				}),
+			},
+		}
+	}
+
+	return &gqlObject{
+		typename: "Repository",
+		fields: map[string]gqlResolver{
+			"id":            gqlValue(r.doc.string("node_id")),
+			"databaseId":    gqlValue(r.doc.id()),
+			"name":          gqlValue(r.doc.string("name")),
+			"nameWithOwner": gqlValue(r.doc.string("full_name")),
+			"isArchived":    gqlValue(r.doc["archived"]),
+			"isPrivate":     gqlValue(r.doc["private"]),
+			"defaultBranchRef": func(args map[string]interface{}) (interface{}, error) {
+				branch := r.doc.string("default_branch")
+				if _, ok := r.branches[branch]; !ok {
+					return (*gqlObject)(nil), nil
+				}
+				return refObject(branch), nil
+			},
+			"ref": func(args map[string]interface{}) (interface{}, error) {
+				branch := strings.TrimPrefix(stringArg(args, "qualifiedName"), "refs/heads/")
+				if _, ok := r.branches[branch]; !ok {
+					return (*gqlObject)(nil), nil
+				}
+				return refObject(branch), nil
+			},
+		},
+	}
+}
+
+func stringArg(args map[string]interface{}, name string) string {
+	s, _ := args[name].(string)
+	return s
+}
+
+// parseGraphQL parses a GraphQL document holding a single operation, binding
+// its variables.
+func parseGraphQL(query string, variables map[string]interface{}) (string, []gqlSelection, error) {
+	p := &gqlParser{input: query, variables: variables}
+	p.next()
+
+	operation := "query"
+	if p.kind == gqlName {
+		operation = p.token
+		p.next()
+		if p.kind == gqlName {
+			// The operation name
+			p.next()
+		}
+		if p.is("(") {
+			p.skipVariableDefinitions()
+		}
+	}
+
+	selections := p.selectionSet()
+	if p.err == nil && p.kind != gqlEOF {
+		p.fail("unexpected %q after the operation", p.token)
+	}
+	return operation, selections, p.err
+}
+
+type gqlTokenKind int
+
+const (
+	gqlEOF gqlTokenKind = iota
+	gqlName
+	gqlPunctuator
+	gqlString
+	gqlNumber
+)
+
+type gqlParser struct {
+	input     string
+	pos       int
+	kind      gqlTokenKind
+	token     string
+	variables map[string]interface{}
+	err       error
+}
+
+func (p *gqlParser) fail(format string, args ...interface{}) {
+	if p.err == nil {
+		p.err = fmt.Errorf("Parse error: "+format, args...)
+	}
+	p.kind = gqlEOF
+}
+
+func (p *gqlParser) is(punctuator string) bool {
+	return p.kind == gqlPunctuator && p.token == punctuator
+}
+
+func (p *gqlParser) expect(punctuator string) {
+	if !p.is(punctuator) {
+		p.fail("expected %q, got %q", punctuator, p.token)
+		return
+	}
+	p.next()
+}
+
+// next reads the next token, skipping whitespace, commas and comments
+func (p *gqlParser) next() {
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c == '#' {
+			for p.pos < len(p.input) && p.input[p.pos] != '\n' {
+				p.pos++
+			}
+			continue
+		}
+		if c != ',' && !unicode.IsSpace(rune(c)) {
+			break
+		}
+		p.pos++
+	}
+	if p.pos >= len(p.input) {
+		p.kind, p.token = gqlEOF, ""
+		return
+	}
+
+	start := p.pos
+	c := p.input[p.pos]
+	switch {
+	case c == '_' || unicode.IsLetter(rune(c)):
+		for p.pos < len(p.input) && (p.input[p.pos] == '_' || unicode.IsLetter(rune(p.input[p.pos])) || unicode.IsDigit(rune(p.input[p.pos]))) {
+			p.pos++
+		}
+		p.kind = gqlName
+	case c == '-' || unicode.IsDigit(rune(c)):
+		p.pos++
+		for p.pos < len(p.input) && strings.ContainsRune("0123456789.eE+-", rune(p.input[p.pos])) {
+			p.pos++
+		}
+		p.kind = gqlNumber
+	case c == '"':
+		p.pos++
+		for p.pos < len(p.input) && p.input[p.pos] != '"' {
+			if p.input[p.pos] == '\\' {
+				p.pos++
+			}
+			p.pos++
+		}
+		p.pos++
+		p.kind = gqlString
+	case strings.HasPrefix(p.input[p.pos:], "..."):
+		p.pos += 3
+		p.kind = gqlPunctuator
+	default:
+		p.pos++
+		p.kind = gqlPunctuator
+	}
+	if p.pos > len(p.input) {
+		p.fail("unterminated string")
+		return
+	}
+	p.token = p.input[start:p.pos]
+}
+
+// skipVariableDefinitions skips the variable definitions of an operation,
+// variables being bound from the request whatever their type
+func (p *gqlParser) skipVariableDefinitions() {
+	for depth := 0; p.kind != gqlEOF; p.next() {
+		if p.is("(") {
+			depth++
+		} else if p.is(")") {
+			depth--
+			if depth == 0 {
+				p.next()
+				return
+			}
+		}
+	}
+	p.fail("unterminated variable definitions")
+}
+
+func (p *gqlParser) selectionSet() []gqlSelection {
+	p.expect("{")
+
+	var selections []gqlSelection
+	for p.err == nil && !p.is("}") {
+		if p.is("...") {
+			p.next()
+			if p.kind != gqlName || p.token != "on" {
+				p.fail("only inline fragments are supported")
+				break
+			}
+			p.next()
+			on := p.token
+			p.next()
+			selections = append(selections, gqlSelection{on: on, selections: p.selectionSet()})
+			continue
+		}
+
+		if p.kind != gqlName {
+			p.fail("expected a field, got %q", p.token)
+			break
+		}
+		sel := gqlSelection{name: p.token}
+		p.next()
+		if p.is(":") {
+			p.next()
+			sel.alias, sel.name = sel.name, p.token
+			p.next()
+		}
+		if p.is("(") {
+			sel.args = p.arguments()
+		}
+		if p.is("{") {
+			sel.selections = p.selectionSet()
+		}
+		selections = append(selections, sel)
+	}
+
+	p.expect("}")
+	return selections
+}
+
+func (p *gqlParser) arguments() map[string]interface{} {
+	p.expect("(")
+	args := map[string]interface{}{}
+	for p.err == nil && !p.is(")") {
+		name := p.token
+		p.next()
+		p.expect(":")
+		args[name] = p.value()
+	}
+	p.expect(")")
+	return args
+}
+
+func (p *gqlParser) value() interface{} {
+	token := p.token
+	switch {
+	case p.is("$"):
+		p.next()
+		name := p.token
+		p.next()
+		return p.variables[name]
+	case p.is("["):
+		p.next()
+		list := []interface{}{}
+		for p.err == nil && !p.is("]") {
+			list = append(list, p.value())
+		}
+		p.expect("]")
+		return list
+	case p.is("{"):
+		p.next()
+		object := map[string]interface{}{}
+		for p.err == nil && !p.is("}") {
+			name := p.token
+			p.next()
+			p.expect(":")
+			object[name] = p.value()
+		}
+		p.expect("}")
+		return object
+	case p.kind == gqlString:
+		p.next()
+		s, err := strconv.Unquote(token)
+		if err != nil {
+			p.fail("invalid string %s", token)
+		}
+		return s
+	case p.kind == gqlNumber:
+		p.next()
+		n, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			p.fail("invalid number %s", token)
+		}
+		return n
+	case p.kind == gqlName:
+		p.next()
+		switch token {
+		case "true":
+			return true
+		case "false":
+			return false
+		case "null":
+			return nil
+		}
+		// An enum value
+		return token
+	}
+	p.fail("unexpected %q", token)
+	return nil
+}
@@ -0,0 +1,785 @@
+package fakegithub
+
+import (
+	"fmt"
+	"time"
+)
+
+// Projects (v2) only exist in the GraphQL API, so their state and mutations
+// live here rather than next to the REST handlers.
+
+type project struct {
+	doc    document
+	fields []*projectField
+	items  []*projectItem
+	views  []document
+}
+
+type projectField struct {
+	doc        document
+	options    []document
+	duration   int
+	iterations []document
+}
+
+type projectItem struct {
+	doc document
+	// draft is the draft issue of the item, if it is one
+	draft document
+	// values are the values of the fields of the item by field node ID
+	values map[string]interface{}
+}
+
+func (f *projectField) typename() string {
+	switch f.doc.string("dataType") {
+	case "SINGLE_SELECT":
+		return "ProjectV2SingleSelectField"
+	case "ITERATION":
+		return "ProjectV2IterationField"
+	}
+	return "ProjectV2Field"
+}
+
+func (f *projectField) option(id string) document {
+	for _, o := range f.options {
+		if o.string("id") == id {
+			return o
+		}
+	}
+	return nil
+}
+
+func (f *projectField) iteration(id string) document {
+	for _, i := range f.iterations {
+		if i.string("id") == id {
+			return i
+		}
+	}
+	return nil
+}
+
+func inputArg(args map[string]interface{}) map[string]interface{} {
+	input, _ := args["input"].(map[string]interface{})
+	if input == nil {
+		input = map[string]interface{}{}
+	}
+	return input
+}
+
+// gqlPayload returns the payload of a mutation, echoing its clientMutationId
+func gqlPayload(typename string, input map[string]interface{}, fields map[string]gqlResolver) *gqlObject {
+	fields["clientMutationId"] = gqlValue(input["clientMutationId"])
+	return &gqlObject{typename: typename, fields: fields}
+}
+
+func (s *Server) mutationRoot() *gqlObject {
+	return &gqlObject{
+		typename: "Mutation",
+		fields: map[string]gqlResolver{
+			"createProjectV2":               s.createProjectV2,
+			"updateProjectV2":               s.updateProjectV2,
+			"deleteProjectV2":               s.deleteProjectV2,
+			"createProjectV2Field":          s.createProjectV2Field,
+			"updateProjectV2Field":          s.updateProjectV2Field,
+			"deleteProjectV2Field":          s.deleteProjectV2Field,
+			"addProjectV2ItemById":          s.addProjectV2ItemByID,
+			"addProjectV2DraftIssue":        s.addProjectV2DraftIssue,
+			"updateProjectV2DraftIssue":     s.updateProjectV2DraftIssue,
+			"updateProjectV2ItemFieldValue": s.updateProjectV2ItemFieldValue,
+			"clearProjectV2ItemFieldValue":  s.clearProjectV2ItemFieldValue,
+			"deleteProjectV2Item":           s.deleteProjectV2Item,
+		},
+	}
+}
+
+func notFoundNode(id string) error {
+	return fmt.Errorf("Could not resolve to a node with the global id of '%s'", id)
+}
+
+func (s *Server) projectByID(id string) (*project, error) {
+	p, ok := s.projects[id]
+	if !ok {
+		return nil, notFoundNode(id)
+	}
+	return p, nil
+}
+
+// projectByNumber returns the project of an owner, given its node ID
+func (s *Server) projectByNumber(ownerID string, number int) *project {
+	for _, p := range s.projects {
+		if p.doc.string("owner") == ownerID && p.doc["number"] == number {
+			return p
+		}
+	}
+	return nil
+}
+
+func (s *Server) projectField(id string) (*project, *projectField, error) {
+	for _, p := range s.projects {
+		for _, f := range p.fields {
+			if f.doc.string("node_id") == id {
+				return p, f, nil
+			}
+		}
+	}
+	return nil, nil, notFoundNode(id)
+}
+
+func (s *Server) projectItem(id string) (*project, *projectItem, error) {
+	for _, p := range s.projects {
+		for _, i := range p.items {
+			if i.doc.string("node_id") == id || (i.draft != nil && i.draft.string("node_id") == id) {
+				return p, i, nil
+			}
+		}
+	}
+	return nil, nil, notFoundNode(id)
+}
+
+func (s *Server) newProjectField(p *project, dataType, name string) *projectField {
+	prefix := map[string]string{"SINGLE_SELECT": "PVTSSF", "ITERATION": "PVTIF"}[dataType]
+	if prefix == "" {
+		prefix = "PVTF"
+	}
+	f := &projectField{
+		doc: document{
+			"node_id":  nodeID(prefix, s.newID()),
+			"name":     name,
+			"dataType": dataType,
+		},
+	}
+	p.fields = append(p.fields, f)
+	return f
+}
+
+func (s *Server) setProjectFieldOptions(f *projectField, options []interface{}) {
+	f.options = nil
+	for _, v := range options {
+		o, _ := v.(map[string]interface{})
+		f.options = append(f.options, document{
+			"id":          fmt.Sprintf("%08x", s.newID()),
+			"name":        stringArg(o, "name"),
+			"color":       stringArg(o, "color"),
+			"description": stringArg(o, "description"),
+		})
+	}
+}
+
+// setProjectFieldIterations replaces the iterations of a field, which are
+// three iterations from the start date unless given
+func (s *Server) setProjectFieldIterations(f *projectField, configuration map[string]interface{}) error {
+	start, err := time.Parse("2006-01-02", stringArg(configuration, "startDate"))
+	if err != nil {
+		return fmt.Errorf("Argument 'startDate' on InputObject 'ProjectV2IterationFieldConfigurationInput' has an invalid value")
+	}
+	duration, _ := configuration["duration"].(float64)
+	if duration < 1 {
+		return fmt.Errorf("Duration must be at least one day")
+	}
+	f.duration = int(duration)
+
+	f.iterations = nil
+	iterations, _ := configuration["iterations"].([]interface{})
+	if len(iterations) == 0 {
+		for n := 0; n < 3; n++ {
+			iterations = append(iterations, map[string]interface{}{
+				"title":     fmt.Sprintf("Iteration %d", n+1),
+				"startDate": start.AddDate(0, 0, n*f.duration).Format("2006-01-02"),
+				"duration":  duration,
+			})
+		}
+	}
+	for _, v := range iterations {
+		i, _ := v.(map[string]interface{})
+		d, _ := i["duration"].(float64)
+		f.iterations = append(f.iterations, document{
+			"id":        fmt.Sprintf("%08x", s.newID()),
+			"title":     stringArg(i, "title"),
+			"startDate": stringArg(i, "startDate"),
+			"duration":  int(d),
+		})
+	}
+	return nil
+}
+
+// clearStaleProjectValues clears the values of a field which refer to options
+// or iterations the field no longer has
+func clearStaleProjectValues(p *project, f *projectField) {
+	id := f.doc.string("node_id")
+	for _, item := range p.items {
+		value, ok := item.values[id].(string)
+		if !ok {
+			continue
+		}
+		if (f.typename() == "ProjectV2SingleSelectField" && f.option(value) == nil) ||
+			(f.typename() == "ProjectV2IterationField" && f.iteration(value) == nil) {
+			delete(item.values, id)
+		}
+	}
+}
+
+func (s *Server) createProjectV2(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	ownerID := stringArg(input, "ownerId")
+	owner := s.node(ownerID)
+	if owner == nil || (owner.typename != "Organization" && owner.typename != "User") {
+		return nil, notFoundNode(ownerID)
+	}
+	if stringArg(input, "title") == "" {
+		return nil, fmt.Errorf("Title can't be blank")
+	}
+
+	login, _ := owner.fields["login"](nil)
+	number := 1
+	for _, p := range s.projects {
+		if p.doc.string("owner") == ownerID && p.doc["number"].(int) >= number {
+			number = p.doc["number"].(int) + 1
+		}
+	}
+	path := "users"
+	if owner.typename == "Organization" {
+		path = "orgs"
+	}
+
+	p := &project{
+		doc: document{
+			"node_id":          nodeID("PVT", s.newID()),
+			"owner":            ownerID,
+			"number":           number,
+			"title":            stringArg(input, "title"),
+			"shortDescription": nil,
+			"readme":           nil,
+			"public":           false,
+			"closed":           false,
+			"url":              fmt.Sprintf("%s/%s/%s/projects/%d", s.URL, path, login, number),
+		},
+		views: []document{{
+			"node_id": nodeID("PVTV", s.newID()),
+			"name":    "View 1",
+			"number":  1,
+			"layout":  "TABLE_LAYOUT",
+		}},
+	}
+	// Every project starts with the built-in title field and a status
+	s.newProjectField(p, "TITLE", "Title")
+	status := s.newProjectField(p, "SINGLE_SELECT", "Status")
+	s.setProjectFieldOptions(status, []interface{}{
+		map[string]interface{}{"name": "Todo", "color": "GREEN"},
+		map[string]interface{}{"name": "In Progress", "color": "YELLOW"},
+		map[string]interface{}{"name": "Done", "color": "PURPLE"},
+	})
+	s.projects[p.doc.string("node_id")] = p
+
+	return gqlPayload("CreateProjectV2Payload", input, map[string]gqlResolver{
+		"projectV2": gqlValue(s.projectObject(p)),
+	}), nil
+}
+
+func (s *Server) updateProjectV2(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	p, err := s.projectByID(stringArg(input, "projectId"))
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range []string{"title", "shortDescription", "readme", "public", "closed"} {
+		if v, ok := input[field]; ok {
+			p.doc[field] = v
+		}
+	}
+	return gqlPayload("UpdateProjectV2Payload", input, map[string]gqlResolver{
+		"projectV2": gqlValue(s.projectObject(p)),
+	}), nil
+}
+
+func (s *Server) deleteProjectV2(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	p, err := s.projectByID(stringArg(input, "projectId"))
+	if err != nil {
+		return nil, err
+	}
+	delete(s.projects, p.doc.string("node_id"))
+	return gqlPayload("DeleteProjectV2Payload", input, map[string]gqlResolver{
+		"projectV2": gqlValue(s.projectObject(p)),
+	}), nil
+}
+
+func (s *Server) createProjectV2Field(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	p, err := s.projectByID(stringArg(input, "projectId"))
+	if err != nil {
+		return nil, err
+	}
+
+	dataType, name := stringArg(input, "dataType"), stringArg(input, "name")
+	if !contains([]string{"TEXT", "NUMBER", "DATE", "SINGLE_SELECT", "ITERATION"}, dataType) {
+		return nil, fmt.Errorf("Argument 'dataType' on InputObject 'CreateProjectV2FieldInput' has an invalid value (%s)", dataType)
+	}
+	for _, f := range p.fields {
+		if f.doc.string("name") == name {
+			return nil, fmt.Errorf("Name has already been taken")
+		}
+	}
+	options, _ := input["singleSelectOptions"].([]interface{})
+	if dataType == "SINGLE_SELECT" && len(options) == 0 {
+		return nil, fmt.Errorf("Single select fields must have at least one option")
+	}
+	configuration, _ := input["iterationConfiguration"].(map[string]interface{})
+	if dataType == "ITERATION" && configuration == nil {
+		return nil, fmt.Errorf("Iteration fields must have an iteration configuration")
+	}
+
+	f := s.newProjectField(p, dataType, name)
+	if dataType == "SINGLE_SELECT" {
+		s.setProjectFieldOptions(f, options)
+	}
+	if dataType == "ITERATION" {
+		if err := s.setProjectFieldIterations(f, configuration); err != nil {
+			p.fields = p.fields[:len(p.fields)-1]
+			return nil, err
+		}
+	}
+
+	return gqlPayload("CreateProjectV2FieldPayload", input, map[string]gqlResolver{
+		"projectV2Field": gqlValue(s.projectFieldObject(p, f)),
+	}), nil
+}
+
+func (s *Server) updateProjectV2Field(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	p, f, err := s.projectField(stringArg(input, "fieldId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if options, ok := input["singleSelectOptions"].([]interface{}); ok {
+		if f.typename() != "ProjectV2SingleSelectField" {
+			return nil, fmt.Errorf("Only single select fields have options")
+		}
+		if len(options) == 0 {
+			return nil, fmt.Errorf("Single select fields must have at least one option")
+		}
+		s.setProjectFieldOptions(f, options)
+	}
+	if configuration, ok := input["iterationConfiguration"].(map[string]interface{}); ok {
+		if f.typename() != "ProjectV2IterationField" {
+			return nil, fmt.Errorf("Only iteration fields have an iteration configuration")
+		}
+		if err := s.setProjectFieldIterations(f, configuration); err != nil {
+			return nil, err
+		}
+	}
+	if name := stringArg(input, "name"); name != "" {
+		f.doc["name"] = name
+	}
+	clearStaleProjectValues(p, f)
+
+	return gqlPayload("UpdateProjectV2FieldPayload", input, map[string]gqlResolver{
+		"projectV2Field": gqlValue(s.projectFieldObject(p, f)),
+	}), nil
+}
+
+func (s *Server) deleteProjectV2Field(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	p, f, err := s.projectField(stringArg(input, "fieldId"))
+	if err != nil {
+		return nil, err
+	}
+	if f.doc.string("dataType") == "TITLE" {
+		return nil, fmt.Errorf("The title field cannot be deleted")
+	}
+
+	id := f.doc.string("node_id")
+	for n, field := range p.fields {
+		if field == f {
+			p.fields = append(p.fields[:n], p.fields[n+1:]...)
+			break
+		}
+	}
+	for _, item := range p.items {
+		delete(item.values, id)
+	}
+
+	return gqlPayload("DeleteProjectV2FieldPayload", input, map[string]gqlResolver{
+		"projectV2Field": gqlValue(s.projectFieldObject(p, f)),
+	}), nil
+}
+
+func (s *Server) addProjectV2ItemByID(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	if _, err := s.projectByID(stringArg(input, "projectId")); err != nil {
+		return nil, err
+	}
+
+	// The server keeps no issues nor pull requests to add
+	contentID := stringArg(input, "contentId")
+	if content := s.node(contentID); content != nil {
+		return nil, fmt.Errorf("Content must be an issue or a pull request, not a %s", content.typename)
+	}
+	return nil, notFoundNode(contentID)
+}
+
+func (s *Server) addProjectV2DraftIssue(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	p, err := s.projectByID(stringArg(input, "projectId"))
+	if err != nil {
+		return nil, err
+	}
+	if stringArg(input, "title") == "" {
+		return nil, fmt.Errorf("Title can't be blank")
+	}
+
+	item := &projectItem{
+		doc: document{
+			"node_id": nodeID("PVTI", s.newID()),
+			"type":    "DRAFT_ISSUE",
+		},
+		draft: document{
+			"node_id": nodeID("DI", s.newID()),
+			"title":   stringArg(input, "title"),
+			"body":    stringArg(input, "body"),
+		},
+		values: make(map[string]interface{}),
+	}
+	p.items = append(p.items, item)
+	runItemAddedWorkflow(p, item)
+
+	return gqlPayload("AddProjectV2DraftIssuePayload", input, map[string]gqlResolver{
+		"projectItem": gqlValue(s.projectItemObject(p, item)),
+	}), nil
+}
+
+func (s *Server) updateProjectV2DraftIssue(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	id := stringArg(input, "draftIssueId")
+	_, item, err := s.projectItem(id)
+	if err != nil || item.draft.string("node_id") != id {
+		return nil, notFoundNode(id)
+	}
+	for _, field := range []string{"title", "body"} {
+		if v, ok := input[field].(string); ok {
+			item.draft[field] = v
+		}
+	}
+	return gqlPayload("UpdateProjectV2DraftIssuePayload", input, map[string]gqlResolver{
+		"draftIssue": gqlValue(s.draftIssueObject(item)),
+	}), nil
+}
+
+// runItemAddedWorkflow mimics the workflow GitHub enables on new projects,
+// which sets the status of the items added to the first option
+func runItemAddedWorkflow(p *project, item *projectItem) {
+	for _, f := range p.fields {
+		if f.doc.string("name") == "Status" && len(f.options) > 0 {
+			item.values[f.doc.string("node_id")] = f.options[0].string("id")
+		}
+	}
+}
+
+// projectItemField returns the item and field a mutation of a field value
+// applies to
+func (s *Server) projectItemField(input map[string]interface{}) (*project, *projectItem, *projectField, error) {
+	p, err := s.projectByID(stringArg(input, "projectId"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	_, item, err := s.projectItem(stringArg(input, "itemId"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	fp, f, err := s.projectField(stringArg(input, "fieldId"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if fp != p || !containsItem(p, item) {
+		return nil, nil, nil, fmt.Errorf("The item and the field must belong to the project")
+	}
+	return p, item, f, nil
+}
+
+func containsItem(p *project, item *projectItem) bool {
+	for _, i := range p.items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) updateProjectV2ItemFieldValue(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	p, item, f, err := s.projectItemField(input)
+	if err != nil {
+		return nil, err
+	}
+
+	value, _ := input["value"].(map[string]interface{})
+	key := map[string]string{
+		"TEXT":          "text",
+		"NUMBER":        "number",
+		"DATE":          "date",
+		"SINGLE_SELECT": "singleSelectOptionId",
+		"ITERATION":     "iterationId",
+	}[f.doc.string("dataType")]
+	if key == "" {
+		return nil, fmt.Errorf("The %s field cannot be updated", f.doc.string("name"))
+	}
+	v, ok := value[key]
+	if !ok || len(value) != 1 {
+		return nil, fmt.Errorf("Field %s of type %s takes a %s value", f.doc.string("name"), f.doc.string("dataType"), key)
+	}
+
+	switch key {
+	case "date":
+		if _, err := time.Parse("2006-01-02", fmt.Sprint(v)); err != nil {
+			return nil, fmt.Errorf("Argument 'date' on InputObject 'ProjectV2FieldValue' has an invalid value (%v)", v)
+		}
+	case "singleSelectOptionId":
+		if f.option(fmt.Sprint(v)) == nil {
+			return nil, fmt.Errorf("Could not find an option with the id %v", v)
+		}
+	case "iterationId":
+		if f.iteration(fmt.Sprint(v)) == nil {
+			return nil, fmt.Errorf("Could not find an iteration with the id %v", v)
+		}
+	}
+	item.values[f.doc.string("node_id")] = v
+
+	return gqlPayload("UpdateProjectV2ItemFieldValuePayload", input, map[string]gqlResolver{
+		"projectV2Item": gqlValue(s.projectItemObject(p, item)),
+	}), nil
+}
+
+func (s *Server) clearProjectV2ItemFieldValue(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	p, item, f, err := s.projectItemField(input)
+	if err != nil {
+		return nil, err
+	}
+	delete(item.values, f.doc.string("node_id"))
+
+	return gqlPayload("ClearProjectV2ItemFieldValuePayload", input, map[string]gqlResolver{
+		"projectV2Item": gqlValue(s.projectItemObject(p, item)),
+	}), nil
+}
+
+func (s *Server) deleteProjectV2Item(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	p, err := s.projectByID(stringArg(input, "projectId"))
+	if err != nil {
+		return nil, err
+	}
+	id := stringArg(input, "itemId")
+	for n, item := range p.items {
+		if item.doc.string("node_id") == id {
+			p.items = append(p.items[:n], p.items[n+1:]...)
+			return gqlPayload("DeleteProjectV2ItemPayload", input, map[string]gqlResolver{
+				"deletedItemId": gqlValue(id),
+			}), nil
+		}
+	}
+	return nil, notFoundNode(id)
+}
+
+// projectNode resolves the node ID of a project, field, item or draft issue
+func (s *Server) projectNode(id string) *gqlObject {
+	if p, ok := s.projects[id]; ok {
+		return s.projectObject(p)
+	}
+	if p, f, err := s.projectField(id); err == nil {
+		return s.projectFieldObject(p, f)
+	}
+	if p, item, err := s.projectItem(id); err == nil {
+		if item.doc.string("node_id") == id {
+			return s.projectItemObject(p, item)
+		}
+		return s.draftIssueObject(item)
+	}
+	return nil
+}
+
+// projectV2Resolver resolves the projectV2 field of an organization or user
+func (s *Server) projectV2Resolver(ownerID string) gqlResolver {
+	return func(args map[string]interface{}) (interface{}, error) {
+		number, _ := args["number"].(float64)
+		p := s.projectByNumber(ownerID, int(number))
+		if p == nil {
+			return nil, fmt.Errorf("Could not resolve to a ProjectV2 with the number %d.", int(number))
+		}
+		return s.projectObject(p), nil
+	}
+}
+
+func (s *Server) projectObject(p *project) *gqlObject {
+	return &gqlObject{
+		typename: "ProjectV2",
+		fields: map[string]gqlResolver{
+			"id":               gqlValue(p.doc.string("node_id")),
+			"number":           gqlValue(p.doc["number"]),
+			"title":            gqlValue(p.doc["title"]),
+			"shortDescription": gqlValue(p.doc["shortDescription"]),
+			"readme":           gqlValue(p.doc["readme"]),
+			"public":           gqlValue(p.doc["public"]),
+			"closed":           gqlValue(p.doc["closed"]),
+			"url":              gqlValue(p.doc["url"]),
+			"fields": func(args map[string]interface{}) (interface{}, error) {
+				fields := make([]*gqlObject, 0, len(p.fields))
+				for _, f := range p.fields {
+					fields = append(fields, s.projectFieldObject(p, f))
+				}
+				return gqlConnection("ProjectV2FieldConfigurationConnection", fields), nil
+			},
+			"items": func(args map[string]interface{}) (interface{}, error) {
+				items := make([]*gqlObject, 0, len(p.items))
+				for _, item := range p.items {
+					items = append(items, s.projectItemObject(p, item))
+				}
+				return gqlConnection("ProjectV2ItemConnection", items), nil
+			},
+			"views": func(args map[string]interface{}) (interface{}, error) {
+				views := make([]*gqlObject, 0, len(p.views))
+				for _, v := range p.views {
+					views = append(views, &gqlObject{
+						typename: "ProjectV2View",
+						fields: map[string]gqlResolver{
+							"id":     gqlValue(v.string("node_id")),
+							"name":   gqlValue(v["name"]),
+							"number": gqlValue(v["number"]),
+							"layout": gqlValue(v["layout"]),
+						},
+					})
+				}
+				return gqlConnection("ProjectV2ViewConnection", views), nil
+			},
+		},
+	}
+}
+
+func (s *Server) projectFieldObject(p *project, f *projectField) *gqlObject {
+	object := &gqlObject{
+		typename: f.typename(),
+		fields: map[string]gqlResolver{
+			"id":       gqlValue(f.doc.string("node_id")),
+			"name":     gqlValue(f.doc["name"]),
+			"dataType": gqlValue(f.doc["dataType"]),
+			"project": func(args map[string]interface{}) (interface{}, error) {
+				return s.projectObject(p), nil
+			},
+		},
+	}
+
+	switch f.typename() {
+	case "ProjectV2SingleSelectField":
+		options := make([]*gqlObject, 0, len(f.options))
+		for _, o := range f.options {
+			options = append(options, documentObject("ProjectV2SingleSelectFieldOption", o))
+		}
+		object.fields["options"] = gqlValue(options)
+	case "ProjectV2IterationField":
+		// Iterations which ended are listed apart
+		today := time.Now().UTC().Format("2006-01-02")
+		var iterations, completed []*gqlObject
+		for _, i := range f.iterations {
+			start, _ := time.Parse("2006-01-02", i.string("startDate"))
+			if start.AddDate(0, 0, i["duration"].(int)).Format("2006-01-02") <= today {
+				completed = append(completed, documentObject("ProjectV2IterationFieldIteration", i))
+			} else {
+				iterations = append(iterations, documentObject("ProjectV2IterationFieldIteration", i))
+			}
+		}
+		object.fields["configuration"] = gqlValue(&gqlObject{
+			typename: "ProjectV2IterationFieldConfiguration",
+			fields: map[string]gqlResolver{
+				"duration":            gqlValue(f.duration),
+				"startDate":           gqlValue(f.iterations[0]["startDate"]),
+				"iterations":          gqlValue(iterations),
+				"completedIterations": gqlValue(completed),
+			},
+		})
+	}
+	return object
+}
+
+func (s *Server) projectItemObject(p *project, item *projectItem) *gqlObject {
+	return &gqlObject{
+		typename: "ProjectV2Item",
+		fields: map[string]gqlResolver{
+			"id":   gqlValue(item.doc.string("node_id")),
+			"type": gqlValue(item.doc["type"]),
+			"project": func(args map[string]interface{}) (interface{}, error) {
+				return s.projectObject(p), nil
+			},
+			"content": gqlValue(s.draftIssueObject(item)),
+			"fieldValues": func(args map[string]interface{}) (interface{}, error) {
+				var values []*gqlObject
+				for _, f := range p.fields {
+					if v := s.projectFieldValueObject(p, item, f); v != nil {
+						values = append(values, v)
+					}
+				}
+				return gqlConnection("ProjectV2ItemFieldValueConnection", values), nil
+			},
+		},
+	}
+}
+
+// projectFieldValueObject returns the value of a field of an item, or nil
+func (s *Server) projectFieldValueObject(p *project, item *projectItem, f *projectField) *gqlObject {
+	value, ok := item.values[f.doc.string("node_id")]
+	if f.doc.string("dataType") == "TITLE" {
+		value, ok = item.draft["title"], item.draft != nil
+	}
+	if !ok {
+		return nil
+	}
+
+	fields := map[string]gqlResolver{
+		"field": gqlValue(s.projectFieldObject(p, f)),
+		"item": func(args map[string]interface{}) (interface{}, error) {
+			return s.projectItemObject(p, item), nil
+		},
+	}
+	typename := ""
+	switch f.doc.string("dataType") {
+	case "TITLE", "TEXT":
+		typename = "ProjectV2ItemFieldTextValue"
+		fields["text"] = gqlValue(value)
+	case "NUMBER":
+		typename = "ProjectV2ItemFieldNumberValue"
+		fields["number"] = gqlValue(value)
+	case "DATE":
+		typename = "ProjectV2ItemFieldDateValue"
+		fields["date"] = gqlValue(value)
+	case "SINGLE_SELECT":
+		typename = "ProjectV2ItemFieldSingleSelectValue"
+		fields["optionId"] = gqlValue(value)
+		fields["name"] = gqlValue(f.option(value.(string))["name"])
+	case "ITERATION":
+		typename = "ProjectV2ItemFieldIterationValue"
+		fields["iterationId"] = gqlValue(value)
+		fields["title"] = gqlValue(f.iteration(value.(string))["title"])
+	}
+	return &gqlObject{typename: typename, fields: fields}
+}
+
+func (s *Server) draftIssueObject(item *projectItem) *gqlObject {
+	if item.draft == nil {
+		return (*gqlObject)(nil)
+	}
+	return &gqlObject{
+		typename: "DraftIssue",
+		fields: map[string]gqlResolver{
+			"id":    gqlValue(item.draft.string("node_id")),
+			"title": gqlValue(item.draft["title"]),
+			"body":  gqlValue(item.draft["body"]),
+		},
+	}
+}
+
+// documentObject returns an object whose fields are those of a document
+func documentObject(typename string, doc document) *gqlObject {
+	fields := make(map[string]gqlResolver, len(doc))
+	for k, v := range doc {
+		fields[k] = gqlValue(v)
+	}
+	return &gqlObject{typename: typename, fields: fields}
+}
@@ -0,0 +1,63 @@
+package fakegithub
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestExecuteGraphQL(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	org := s.organizations[DefaultOrganization]
+	data, err := s.executeGraphQL(gqlRequest{
+		Query: `query($login:String!){
+			viewer{login}
+			org: organization(login: $login){ id, __typename }
+			node(id: "` + org.doc.string("node_id") + `"){ ... on Repository{ name } ... on Organization{ login } }
+		}`,
+		Variables: map[string]interface{}{"login": DefaultOrganization},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	got, _ := json.Marshal(data)
+	expected := `{"node":{"login":"fake-org"},"org":{"__typename":"Organization","id":"` + org.doc.string("node_id") + `"},"viewer":{"login":"fake-user"}}`
+	if string(got) != expected {
+		t.Fatalf("Expected %s, got %s", expected, got)
+	}
+
+	if _, err := s.executeGraphQL(gqlRequest{Query: `{viewer{email}}`}); err == nil {
+		t.Fatal("Expected an error for an unknown field, got nil")
+	}
+	if _, err := s.executeGraphQL(gqlRequest{Query: `{viewer{login}`}); err == nil {
+		t.Fatal("Expected a parse error, got nil")
+	}
+}
+
+func TestExecuteGraphQLMutation(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	org := s.organizations[DefaultOrganization]
+	data, err := s.executeGraphQL(gqlRequest{
+		Query: `mutation($input:CreateProjectV2Input!){createProjectV2(input:$input){projectV2{number, title}, clientMutationId}}`,
+		Variables: map[string]interface{}{
+			"input": map[string]interface{}{"ownerId": org.doc.string("node_id"), "title": "Roadmap", "clientMutationId": "test"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	got, _ := json.Marshal(data)
+	expected := `{"createProjectV2":{"clientMutationId":"test","projectV2":{"number":1,"title":"Roadmap"}}}`
+	if string(got) != expected {
+		t.Fatalf("Expected %s, got %s", expected, got)
+	}
+
+	if _, err := s.executeGraphQL(gqlRequest{Query: `{node(id: "PVT_0"){id}}`}); err == nil {
+		t.Fatal("Expected an error for an unknown node, got nil")
+	}
+}
@@ -0,0 +1,1339 @@
+package fakegithub
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// segment matches one segment of a path
+const segment = `([^/]+)`
+
+func (s *Server) restRoutes() []route {
+	repo := `/repos/` + segment + `/` + segment
+	teamByID := `/organizations/` + segment + `/team/` + segment
+
+	return []route{
+		newRoute("GET", `/meta`, s.getMeta),
+		newRoute("GET", `/user`, s.getAuthenticatedUser),
+		newRoute("GET", `/users/`+segment, s.getUser),
+		newRoute("GET", `/orgs/`+segment, s.getOrganization),
+
+		newRoute("GET", `/orgs/`+segment+`/memberships/`+segment, s.getOrgMembership),
+		newRoute("PUT", `/orgs/`+segment+`/memberships/`+segment, s.setOrgMembership),
+		newRoute("DELETE", `/orgs/`+segment+`/memberships/`+segment, s.removeOrgMembership),
+
+		newRoute("GET", `/orgs/`+segment+`/repos`, s.listOrgRepositories),
+		newRoute("POST", `/orgs/`+segment+`/repos`, s.createOrgRepository),
+		newRoute("POST", `/user/repos`, s.createUserRepository),
+		newRoute("GET", repo, s.getRepository),
+		newRoute("PATCH", repo, s.editRepository),
+		newRoute("DELETE", repo, s.deleteRepository),
+		newRoute("PUT", repo+`/topics`, s.replaceTopics),
+		newRoute("GET", repo+`/vulnerability-alerts`, s.getVulnerabilityAlerts),
+		newRoute("PUT", repo+`/vulnerability-alerts`, s.setVulnerabilityAlerts(true)),
+		newRoute("DELETE", repo+`/vulnerability-alerts`, s.setVulnerabilityAlerts(false)),
+		newRoute("GET", repo+`/pages`, s.getPages),
+
+		newRoute("GET", repo+`/git/ref/(heads/.+)`, s.getRef),
+		newRoute("POST", repo+`/git/refs`, s.createRef),
+		newRoute("DELETE", repo+`/git/refs/(heads/.+)`, s.deleteRef),
+
+		newRoute("GET", repo+`/actions/secrets/public-key`, s.getPublicKey),
+		newRoute("GET", repo+`/actions/secrets/`+segment, s.getSecret),
+		newRoute("PUT", repo+`/actions/secrets/`+segment, s.putSecret),
+		newRoute("DELETE", repo+`/actions/secrets/`+segment, s.deleteSecret),
+
+		newRoute("POST", repo+`/hooks`, s.createHook),
+		newRoute("GET", repo+`/hooks/`+segment, s.getHook),
+		newRoute("PATCH", repo+`/hooks/`+segment, s.editHook),
+		newRoute("DELETE", repo+`/hooks/`+segment, s.deleteHook),
+
+		newRoute("GET", repo+`/rulesets`, s.listRulesets),
+		newRoute("POST", repo+`/rulesets`, s.createRuleset),
+		newRoute("GET", repo+`/rulesets/`+segment, s.getRuleset),
+		newRoute("PUT", repo+`/rulesets/`+segment, s.updateRuleset),
+		newRoute("DELETE", repo+`/rulesets/`+segment, s.deleteRuleset),
+
+		newRoute("GET", `/orgs/`+segment+`/rulesets`, s.listRulesets),
+		newRoute("POST", `/orgs/`+segment+`/rulesets`, s.createOrgRuleset),
+		newRoute("GET", `/orgs/`+segment+`/rulesets/`+segment, s.getRuleset),
+		newRoute("PUT", `/orgs/`+segment+`/rulesets/`+segment, s.updateRuleset),
+		newRoute("DELETE", `/orgs/`+segment+`/rulesets/`+segment, s.deleteRuleset),
+
+		newRoute("GET", `/orgs/`+segment+`/properties/schema/`+segment, s.getCustomProperty),
+		newRoute("PUT", `/orgs/`+segment+`/properties/schema/`+segment, s.putCustomProperty),
+		newRoute("DELETE", `/orgs/`+segment+`/properties/schema/`+segment, s.deleteCustomProperty),
+		newRoute("GET", `/orgs/`+segment+`/properties/values`, s.listCustomPropertyValues),
+		newRoute("GET", repo+`/properties/values`, s.getCustomPropertyValues),
+		newRoute("PATCH", repo+`/properties/values`, s.updateCustomPropertyValues),
+
+		newRoute("POST", `/orgs/`+segment+`/teams`, s.createTeam),
+		newRoute("GET", `/orgs/`+segment+`/teams/`+segment, s.getTeamBySlug),
+		newRoute("DELETE", `/orgs/`+segment+`/teams/`+segment+`/memberships/`+segment, s.removeTeamMembershipBySlug),
+		newRoute("GET", teamByID, s.getTeam),
+		newRoute("PATCH", teamByID, s.editTeam),
+		newRoute("DELETE", teamByID, s.deleteTeam),
+		newRoute("GET", teamByID+`/memberships/`+segment, s.getTeamMembership),
+		newRoute("PUT", teamByID+`/memberships/`+segment, s.setTeamMembership),
+		newRoute("DELETE", teamByID+`/memberships/`+segment, s.removeTeamMembership),
+		newRoute("GET", teamByID+`/repos/`+segment+`/`+segment, s.getTeamRepository),
+		newRoute("PUT", teamByID+`/repos/`+segment+`/`+segment, s.setTeamRepository),
+		newRoute("DELETE", teamByID+`/repos/`+segment+`/`+segment, s.removeTeamRepository),
+	}
+}
+
+func (s *Server) getMeta(e *exchange, params []string) {
+	e.write(http.StatusOK, document{"verifiable_password_authentication": true})
+}
+
+func (s *Server) getAuthenticatedUser(e *exchange, params []string) {
+	e.write(http.StatusOK, s.user)
+}
+
+func (s *Server) getUser(e *exchange, params []string) {
+	user, ok := s.users[strings.ToLower(params[0])]
+	if !ok {
+		e.notFound()
+		return
+	}
+	e.write(http.StatusOK, user)
+}
+
+func (s *Server) organization(e *exchange, login string) (*organization, bool) {
+	org, ok := s.organizations[strings.ToLower(login)]
+	if !ok {
+		e.notFound()
+	}
+	return org, ok
+}
+
+func (s *Server) getOrganization(e *exchange, params []string) {
+	if org, ok := s.organization(e, params[0]); ok {
+		e.write(http.StatusOK, org.doc)
+	}
+}
+
+// organizationByID finds an organization from the ID in a path
+func (s *Server) organizationByID(id string) (*organization, bool) {
+	for _, org := range s.organizations {
+		if strconv.FormatInt(org.doc.id(), 10) == id {
+			return org, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) orgMembership(org *organization, login string) document {
+	return document{
+		"url":          fmt.Sprintf("%s/api/v3/orgs/%s/memberships/%s", s.URL, org.doc.string("login"), login),
+		"state":        "active",
+		"role":         org.memberships[strings.ToLower(login)],
+		"organization": org.doc,
+		"user":         s.users[strings.ToLower(login)],
+	}
+}
+
+func (s *Server) getOrgMembership(e *exchange, params []string) {
+	org, ok := s.organizations[strings.ToLower(params[0])]
+	if !ok {
+		e.notFound()
+		return
+	}
+	if _, ok := org.memberships[strings.ToLower(params[1])]; !ok {
+		e.notFound()
+		return
+	}
+	e.write(http.StatusOK, s.orgMembership(org, params[1]))
+}
+
+func (s *Server) setOrgMembership(e *exchange, params []string) {
+	org, ok := s.organizations[strings.ToLower(params[0])]
+	if !ok {
+		e.notFound()
+		return
+	}
+	if _, ok := s.users[strings.ToLower(params[1])]; !ok {
+		e.notFound()
+		return
+	}
+
+	var req struct {
+		Role string `json:"role"`
+	}
+	if !e.decode(&req) {
+		return
+	}
+	if req.Role == "" {
+		req.Role = "member"
+	}
+
+	org.memberships[strings.ToLower(params[1])] = req.Role
+	e.write(http.StatusOK, s.orgMembership(org, params[1]))
+}
+
+func (s *Server) removeOrgMembership(e *exchange, params []string) {
+	org, ok := s.organizations[strings.ToLower(params[0])]
+	if !ok {
+		e.notFound()
+		return
+	}
+	login := strings.ToLower(params[1])
+	if _, ok := org.memberships[login]; !ok {
+		e.notFound()
+		return
+	}
+
+	delete(org.memberships, login)
+	for _, t := range org.teams {
+		delete(t.memberships, login)
+	}
+	e.noContent()
+}
+
+// repositoryRequestFields are accepted when creating a repository but are not
+// part of its representation
+var repositoryRequestFields = []string{"auto_init", "gitignore_template", "license_template", "team_id"}
+
+func (s *Server) createOrgRepository(e *exchange, params []string) {
+	org, ok := s.organizations[strings.ToLower(params[0])]
+	if !ok {
+		e.notFound()
+		return
+	}
+	s.createRepository(e, org.doc)
+}
+
+func (s *Server) createUserRepository(e *exchange, params []string) {
+	s.createRepository(e, s.user)
+}
+
+func (s *Server) createRepository(e *exchange, owner document) {
+	var req document
+	if !e.decode(&req) {
+		return
+	}
+
+	name := req.string("name")
+	if name == "" {
+		writeError(e.w, http.StatusUnprocessableEntity, "Repository creation failed.")
+		return
+	}
+	ownerLogin := owner.string("login")
+	key := repositoryKey(ownerLogin, name)
+	if _, ok := s.repositories[key]; ok {
+		writeError(e.w, http.StatusUnprocessableEntity, "Repository creation failed.")
+		return
+	}
+
+	id := s.newID()
+	now := timestamp(time.Now())
+	doc := document{
+		"id":                          id,
+		"node_id":                     nodeID("R", id),
+		"owner":                       owner,
+		"private":                     false,
+		"visibility":                  "public",
+		"description":                 "",
+		"homepage":                    "",
+		"default_branch":              "main",
+		"topics":                      []interface{}{},
+		"has_issues":                  true,
+		"has_projects":                true,
+		"has_wiki":                    true,
+		"has_downloads":               true,
+		"has_discussions":             false,
+		"has_pages":                   false,
+		"is_template":                 false,
+		"archived":                    false,
+		"allow_merge_commit":          true,
+		"allow_squash_merge":          true,
+		"allow_rebase_merge":          true,
+		"allow_auto_merge":            false,
+		"allow_update_branch":         false,
+		"delete_branch_on_merge":      false,
+		"merge_commit_title":          "MERGE_MESSAGE",
+		"merge_commit_message":        "PR_TITLE",
+		"squash_merge_commit_title":   "COMMIT_OR_PR_TITLE",
+		"squash_merge_commit_message": "COMMIT_MESSAGES",
+		"created_at":                  now,
+		"updated_at":                  now,
+	}
+	doc.merge(req, repositoryRequestFields...)
+	if _, ok := req["visibility"]; !ok && req["private"] == true {
+		doc["visibility"] = "private"
+	}
+	doc["private"] = doc.string("visibility") != "public"
+	setRepositoryName(doc, s.URL, ownerLogin, name)
+
+	r := &repository{
+		doc:      doc,
+		branches: make(map[string]string),
+		hooks:    make(map[int64]document),
+		rulesets: make(map[int64]document),
+		secrets:  make(map[string]*secret),
+
+		customProperties: make(map[string]interface{}),
+	}
+	if req["auto_init"] == true {
+		r.branches["main"] = commitSHA(ownerLogin, name, "main")
+	}
+	s.repositories[key] = r
+
+	e.write(http.StatusCreated, doc)
+}
+
+// setRepositoryName sets the name of a repository and the fields derived from
+// it
+func setRepositoryName(doc document, baseURL, owner, name string) {
+	fullName := owner + "/" + name
+	doc["name"] = name
+	doc["full_name"] = fullName
+	doc["url"] = baseURL + "/api/v3/repos/" + fullName
+	doc["html_url"] = baseURL + "/" + fullName
+	doc["clone_url"] = baseURL + "/" + fullName + ".git"
+	doc["git_url"] = "git://" + strings.TrimPrefix(baseURL, "http://") + "/" + fullName + ".git"
+	doc["ssh_url"] = "git@" + strings.TrimPrefix(baseURL, "http://") + ":" + fullName + ".git"
+	doc["svn_url"] = baseURL + "/" + fullName
+}
+
+// commitSHA returns a stable, made up commit SHA
+func commitSHA(parts ...string) string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(strings.Join(parts, "/"))))
+}
+
+func (s *Server) repository(e *exchange, owner, name string) (*repository, bool) {
+	r, ok := s.repositories[repositoryKey(owner, name)]
+	if !ok {
+		e.notFound()
+	}
+	return r, ok
+}
+
+func (s *Server) listOrgRepositories(e *exchange, params []string) {
+	if _, ok := s.organizations[strings.ToLower(params[0])]; !ok {
+		e.notFound()
+		return
+	}
+
+	prefix := repositoryKey(params[0], "")
+	keys := make([]string, 0)
+	for key := range s.repositories {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	repositories := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		repositories = append(repositories, s.repositories[key].doc)
+	}
+	e.writePage(repositories)
+}
+
+func (s *Server) getRepository(e *exchange, params []string) {
+	if r, ok := s.repository(e, params[0], params[1]); ok {
+		e.write(http.StatusOK, r.doc)
+	}
+}
+
+func (s *Server) editRepository(e *exchange, params []string) {
+	r, ok := s.repository(e, params[0], params[1])
+	if !ok {
+		return
+	}
+
+	var req document
+	if !e.decode(&req) {
+		return
+	}
+
+	if r.doc["archived"] == true && req["archived"] != false {
+		writeError(e.w, http.StatusForbidden, "Repository was archived so is read-only.")
+		return
+	}
+
+	// Topics are only set through their own endpoint
+	r.doc.merge(req, append(repositoryRequestFields, "name", "topics", "private")...)
+	if private, ok := req["private"].(bool); ok && req["visibility"] == nil {
+		r.doc["visibility"] = "public"
+		if private {
+			r.doc["visibility"] = "private"
+		}
+	}
+	r.doc["private"] = r.doc.string("visibility") != "public"
+	r.doc["updated_at"] = timestamp(time.Now())
+
+	if name := req.string("name"); name != "" && name != r.doc.string("name") {
+		owner := params[0]
+		delete(s.repositories, repositoryKey(owner, params[1]))
+		setRepositoryName(r.doc, s.URL, owner, name)
+		s.repositories[repositoryKey(owner, name)] = r
+	}
+
+	e.write(http.StatusOK, r.doc)
+}
+
+func (s *Server) deleteRepository(e *exchange, params []string) {
+	if _, ok := s.repository(e, params[0], params[1]); ok {
+		delete(s.repositories, repositoryKey(params[0], params[1]))
+		e.noContent()
+	}
+}
+
+func (s *Server) replaceTopics(e *exchange, params []string) {
+	r, ok := s.repository(e, params[0], params[1])
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Names []string `json:"names"`
+	}
+	if !e.decode(&req) {
+		return
+	}
+
+	topics := make([]interface{}, 0, len(req.Names))
+	for _, name := range req.Names {
+		topics = append(topics, name)
+	}
+	r.doc["topics"] = topics
+	e.write(http.StatusOK, document{"names": topics})
+}
+
+func (s *Server) getVulnerabilityAlerts(e *exchange, params []string) {
+	if r, ok := s.repository(e, params[0], params[1]); ok {
+		if !r.vulnerabilityAlerts {
+			e.notFound()
+			return
+		}
+		e.noContent()
+	}
+}
+
+func (s *Server) setVulnerabilityAlerts(enabled bool) func(e *exchange, params []string) {
+	return func(e *exchange, params []string) {
+		if r, ok := s.repository(e, params[0], params[1]); ok {
+			r.vulnerabilityAlerts = enabled
+			e.noContent()
+		}
+	}
+}
+
+func (s *Server) getPages(e *exchange, params []string) {
+	// GitHub Pages sites are not supported, so no repository has one
+	e.notFound()
+}
+
+func (s *Server) ref(r *repository, branch string) document {
+	ref := "refs/heads/" + branch
+	return document{
+		"ref":     ref,
+		"node_id": base64.StdEncoding.EncodeToString([]byte(r.doc.string("full_name") + ":" + ref)),
+		"url":     r.doc.string("url") + "/git/" + ref,
+		"object": document{
+			"type": "commit",
+			"sha":  r.branches[branch],
+			"url":  r.doc.string("url") + "/git/commits/" + r.branches[branch],
+		},
+	}
+}
+
+func (s *Server) getRef(e *exchange, params []string) {
+	r, ok := s.repository(e, params[0], params[1])
+	if !ok {
+		return
+	}
+
+	branch := strings.TrimPrefix(params[2], "heads/")
+	if _, ok := r.branches[branch]; !ok {
+		e.notFound()
+		return
+	}
+	e.write(http.StatusOK, s.ref(r, branch))
+}
+
+func (s *Server) createRef(e *exchange, params []string) {
+	r, ok := s.repository(e, params[0], params[1])
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Ref string `json:"ref"`
+		SHA string `json:"sha"`
+	}
+	if !e.decode(&req) {
+		return
+	}
+
+	if !strings.HasPrefix(req.Ref, "refs/heads/") {
+		writeError(e.w, http.StatusUnprocessableEntity, "Reference name must start with 'refs/heads/'")
+		return
+	}
+	branch := strings.TrimPrefix(req.Ref, "refs/heads/")
+	if _, ok := r.branches[branch]; ok {
+		writeError(e.w, http.StatusUnprocessableEntity, "Reference already exists")
+		return
+	}
+	if !r.hasCommit(req.SHA) {
+		writeError(e.w, http.StatusUnprocessableEntity, "Object does not exist")
+		return
+	}
+
+	r.branches[branch] = req.SHA
+	e.write(http.StatusCreated, s.ref(r, branch))
+}
+
+// hasCommit tells whether sha is the head of one of the branches, the only
+// commits the server knows of
+func (r *repository) hasCommit(sha string) bool {
+	for _, head := range r.branches {
+		if head == sha {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) deleteRef(e *exchange, params []string) {
+	r, ok := s.repository(e, params[0], params[1])
+	if !ok {
+		return
+	}
+
+	branch := strings.TrimPrefix(params[2], "heads/")
+	if _, ok := r.branches[branch]; !ok {
+		writeError(e.w, http.StatusUnprocessableEntity, "Reference does not exist")
+		return
+	}
+	delete(r.branches, branch)
+	e.noContent()
+}
+
+// publicKeyID identifies the key pair of the server
+const publicKeyID = "568250167242549743"
+
+func (s *Server) getPublicKey(e *exchange, params []string) {
+	if _, ok := s.repository(e, params[0], params[1]); ok {
+		e.write(http.StatusOK, document{
+			"key_id": publicKeyID,
+			"key":    base64.StdEncoding.EncodeToString(s.publicKey[:]),
+		})
+	}
+}
+
+func (s *Server) getSecret(e *exchange, params []string) {
+	r, ok := s.repository(e, params[0], params[1])
+	if !ok {
+		return
+	}
+
+	sec, ok := r.secrets[params[2]]
+	if !ok {
+		e.notFound()
+		return
+	}
+	e.write(http.StatusOK, document{
+		"name":       params[2],
+		"created_at": timestamp(sec.createdAt),
+		"updated_at": timestamp(sec.updatedAt),
+	})
+}
+
+func (s *Server) putSecret(e *exchange, params []string) {
+	r, ok := s.repository(e, params[0], params[1])
+	if !ok {
+		return
+	}
+
+	var req struct {
+		KeyID          string `json:"key_id"`
+		EncryptedValue string `json:"encrypted_value"`
+	}
+	if !e.decode(&req) {
+		return
+	}
+	if req.KeyID != publicKeyID {
+		writeError(e.w, http.StatusUnprocessableEntity, "Bad request: key_id does not match the repository public key")
+		return
+	}
+	if _, err := base64.StdEncoding.DecodeString(req.EncryptedValue); err != nil {
+		writeError(e.w, http.StatusUnprocessableEntity, "Bad request: encrypted_value is not valid base64")
+		return
+	}
+
+	now := time.Now()
+	if sec, ok := r.secrets[params[2]]; ok {
+		sec.encryptedValue = req.EncryptedValue
+		sec.updatedAt = now
+		e.noContent()
+		return
+	}
+	r.secrets[params[2]] = &secret{encryptedValue: req.EncryptedValue, createdAt: now, updatedAt: now}
+	e.write(http.StatusCreated, document{})
+}
+
+func (s *Server) deleteSecret(e *exchange, params []string) {
+	r, ok := s.repository(e, params[0], params[1])
+	if !ok {
+		return
+	}
+	if _, ok := r.secrets[params[2]]; !ok {
+		e.notFound()
+		return
+	}
+	delete(r.secrets, params[2])
+	e.noContent()
+}
+
+// hookSecretMask replaces the secret of a webhook in responses
+const hookSecretMask = "********"
+
+// hookResponse returns a webhook as the API returns it, without its secret
+func hookResponse(hook document) document {
+	response := hook.copy()
+	if config, ok := response["config"].(map[string]interface{}); ok {
+		if _, ok := config["secret"]; ok {
+			config["secret"] = hookSecretMask
+		}
+	}
+	return response
+}
+
+func (s *Server) createHook(e *exchange, params []string) {
+	r, ok := s.repository(e, params[0], params[1])
+	if !ok {
+		return
+	}
+
+	var req document
+	if !e.decode(&req) {
+		return
+	}
+
+	id := s.newID()
+	now := timestamp(time.Now())
+	hook := document{
+		"id":         id,
+		"type":       "Repository",
+		"name":       "web",
+		"active":     true,
+		"events":     []interface{}{"push"},
+		"config":     map[string]interface{}{},
+		"created_at": now,
+		"updated_at": now,
+	}
+	hook.merge(req)
+	hook["url"] = fmt.Sprintf("%s/hooks/%d", r.doc.string("url"), id)
+	normalizeHookConfig(hook)
+
+	r.hooks[id] = hook
+	e.write(http.StatusCreated, hookResponse(hook))
+}
+
+// normalizeHookConfig fills in the defaults of the configuration of a webhook
+func normalizeHookConfig(hook document) {
+	config, _ := hook["config"].(map[string]interface{})
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+	if _, ok := config["content_type"]; !ok {
+		config["content_type"] = "form"
+	}
+	if _, ok := config["insecure_ssl"]; !ok {
+		config["insecure_ssl"] = "0"
+	}
+	hook["config"] = config
+}
+
+func (s *Server) hook(e *exchange, params []string) (document, *repository, bool) {
+	r, ok := s.repository(e, params[0], params[1])
+	if !ok {
+		return nil, nil, false
+	}
+
+	id, err := strconv.ParseInt(params[2], 10, 64)
+	if err != nil {
+		e.notFound()
+		return nil, nil, false
+	}
+	hook, ok := r.hooks[id]
+	if !ok {
+		e.notFound()
+		return nil, nil, false
+	}
+	return hook, r, true
+}
+
+func (s *Server) getHook(e *exchange, params []string) {
+	if hook, _, ok := s.hook(e, params); ok {
+		e.write(http.StatusOK, hookResponse(hook))
+	}
+}
+
+func (s *Server) editHook(e *exchange, params []string) {
+	hook, _, ok := s.hook(e, params)
+	if !ok {
+		return
+	}
+
+	var req document
+	if !e.decode(&req) {
+		return
+	}
+
+	hook.merge(req, "id", "url", "type", "created_at")
+	normalizeHookConfig(hook)
+	hook["updated_at"] = timestamp(time.Now())
+	e.write(http.StatusOK, hookResponse(hook))
+}
+
+func (s *Server) deleteHook(e *exchange, params []string) {
+	if hook, r, ok := s.hook(e, params); ok {
+		delete(r.hooks, hook.id())
+		e.noContent()
+	}
+}
+
+// listRulesets lists the rulesets of a repository, including those of its
+// organization unless includes_parents is false, or of an organization
+func (s *Server) listRulesets(e *exchange, params []string) {
+	var all []map[int64]document
+	if len(params) == 2 {
+		r, ok := s.repository(e, params[0], params[1])
+		if !ok {
+			return
+		}
+		all = append(all, r.rulesets)
+		if org, ok := s.organizations[strings.ToLower(params[0])]; ok && e.r.URL.Query().Get("includes_parents") != "false" {
+			all = append(all, org.rulesets)
+		}
+	} else {
+		org, ok := s.organization(e, params[0])
+		if !ok {
+			return
+		}
+		all = append(all, org.rulesets)
+	}
+
+	ids := make([]int64, 0)
+	byID := make(map[int64]document)
+	for _, rulesets := range all {
+		for id, rs := range rulesets {
+			ids = append(ids, id)
+			byID[id] = rs
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	items := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		summary := byID[id].copy()
+		delete(summary, "bypass_actors")
+		delete(summary, "conditions")
+		delete(summary, "rules")
+		items = append(items, summary)
+	}
+	e.writePage(items)
+}
+
+func (s *Server) createRuleset(e *exchange, params []string) {
+	if r, ok := s.repository(e, params[0], params[1]); ok {
+		s.addRuleset(e, r.rulesets, "Repository", r.doc.string("full_name"))
+	}
+}
+
+func (s *Server) createOrgRuleset(e *exchange, params []string) {
+	if org, ok := s.organization(e, params[0]); ok {
+		s.addRuleset(e, org.rulesets, "Organization", org.doc.string("login"))
+	}
+}
+
+// addRuleset creates a ruleset of a repository or an organization
+func (s *Server) addRuleset(e *exchange, rulesets map[int64]document, sourceType, source string) {
+	var req document
+	if !e.decode(&req) {
+		return
+	}
+	if req.string("name") == "" {
+		writeError(e.w, http.StatusUnprocessableEntity, "Validation Failed")
+		return
+	}
+
+	id := s.newID()
+	rs := document{
+		"id":            id,
+		"node_id":       nodeID("RRS", id),
+		"source_type":   sourceType,
+		"source":        source,
+		"target":        "branch",
+		"bypass_actors": []interface{}{},
+		"conditions":    map[string]interface{}{},
+		"rules":         []interface{}{},
+	}
+	rs.merge(req, "id", "node_id", "source_type", "source")
+
+	rulesets[id] = rs
+	e.write(http.StatusCreated, rs)
+}
+
+// rulesets returns the rulesets of the repository or the organization in
+// the path, followed by the ID of the ruleset
+func (s *Server) rulesets(e *exchange, params []string) (map[int64]document, bool) {
+	if len(params) == 3 {
+		r, ok := s.repository(e, params[0], params[1])
+		if !ok {
+			return nil, false
+		}
+		return r.rulesets, true
+	}
+
+	org, ok := s.organization(e, params[0])
+	if !ok {
+		return nil, false
+	}
+	return org.rulesets, true
+}
+
+func (s *Server) ruleset(e *exchange, params []string) (document, map[int64]document, bool) {
+	rulesets, ok := s.rulesets(e, params)
+	if !ok {
+		return nil, nil, false
+	}
+
+	id, err := strconv.ParseInt(params[len(params)-1], 10, 64)
+	if err != nil {
+		e.notFound()
+		return nil, nil, false
+	}
+	rs, ok := rulesets[id]
+	if !ok {
+		e.notFound()
+		return nil, nil, false
+	}
+	return rs, rulesets, true
+}
+
+func (s *Server) getRuleset(e *exchange, params []string) {
+	if rs, _, ok := s.ruleset(e, params); ok {
+		e.write(http.StatusOK, rs)
+	}
+}
+
+func (s *Server) updateRuleset(e *exchange, params []string) {
+	rs, _, ok := s.ruleset(e, params)
+	if !ok {
+		return
+	}
+
+	var req document
+	if !e.decode(&req) {
+		return
+	}
+
+	rs.merge(req, "id", "node_id", "source_type", "source")
+	e.write(http.StatusOK, rs)
+}
+
+func (s *Server) deleteRuleset(e *exchange, params []string) {
+	if rs, rulesets, ok := s.ruleset(e, params); ok {
+		delete(rulesets, rs.id())
+		e.noContent()
+	}
+}
+
+func (s *Server) customProperty(e *exchange, params []string) (document, *organization, bool) {
+	org, ok := s.organization(e, params[0])
+	if !ok {
+		return nil, nil, false
+	}
+	property, ok := org.customProperties[params[1]]
+	if !ok {
+		e.notFound()
+		return nil, nil, false
+	}
+	return property, org, true
+}
+
+func (s *Server) getCustomProperty(e *exchange, params []string) {
+	if property, _, ok := s.customProperty(e, params); ok {
+		e.write(http.StatusOK, property)
+	}
+}
+
+// putCustomProperty creates or replaces a custom property of an organization
+func (s *Server) putCustomProperty(e *exchange, params []string) {
+	org, ok := s.organization(e, params[0])
+	if !ok {
+		return
+	}
+
+	var req document
+	if !e.decode(&req) {
+		return
+	}
+	valueType := req.string("value_type")
+	switch valueType {
+	case "string", "single_select", "multi_select", "true_false":
+	default:
+		writeError(e.w, http.StatusUnprocessableEntity, "Validation Failed")
+		return
+	}
+
+	property := document{
+		"property_name":  params[1],
+		"value_type":     valueType,
+		"required":       false,
+		"default_value":  nil,
+		"description":    nil,
+		"allowed_values": nil,
+	}
+	property.merge(req, "property_name")
+
+	org.customProperties[params[1]] = property
+	e.write(http.StatusOK, property)
+}
+
+func (s *Server) deleteCustomProperty(e *exchange, params []string) {
+	property, org, ok := s.customProperty(e, params)
+	if !ok {
+		return
+	}
+
+	name := property.string("property_name")
+	delete(org.customProperties, name)
+	for key, r := range s.repositories {
+		if strings.HasPrefix(key, repositoryKey(params[0], "")) {
+			delete(r.customProperties, name)
+		}
+	}
+	e.noContent()
+}
+
+// customPropertyValues returns the custom property values of a repository as
+// the API lists them, sorted by property name
+func (r *repository) customPropertyValues() []interface{} {
+	names := make([]string, 0, len(r.customProperties))
+	for name := range r.customProperties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		values = append(values, document{
+			"property_name": name,
+			"value":         r.customProperties[name],
+		})
+	}
+	return values
+}
+
+// listCustomPropertyValues lists the custom property values of every
+// repository of an organization
+func (s *Server) listCustomPropertyValues(e *exchange, params []string) {
+	if _, ok := s.organization(e, params[0]); !ok {
+		return
+	}
+
+	prefix := repositoryKey(params[0], "")
+	keys := make([]string, 0)
+	for key := range s.repositories {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	items := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		r := s.repositories[key]
+		items = append(items, document{
+			"repository_id":        r.doc.id(),
+			"repository_name":      r.doc.string("name"),
+			"repository_full_name": r.doc.string("full_name"),
+			"properties":           r.customPropertyValues(),
+		})
+	}
+	e.writePage(items)
+}
+
+func (s *Server) getCustomPropertyValues(e *exchange, params []string) {
+	if r, ok := s.repository(e, params[0], params[1]); ok {
+		e.write(http.StatusOK, r.customPropertyValues())
+	}
+}
+
+// updateCustomPropertyValues sets the custom property values of a
+// repository, removing those set to null
+func (s *Server) updateCustomPropertyValues(e *exchange, params []string) {
+	r, ok := s.repository(e, params[0], params[1])
+	if !ok {
+		return
+	}
+	org, ok := s.organization(e, params[0])
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Properties []struct {
+			PropertyName string      `json:"property_name"`
+			Value        interface{} `json:"value"`
+		} `json:"properties"`
+	}
+	if !e.decode(&req) {
+		return
+	}
+
+	for _, p := range req.Properties {
+		property, ok := org.customProperties[p.PropertyName]
+		if !ok {
+			writeError(e.w, http.StatusUnprocessableEntity, "Validation Failed")
+			return
+		}
+		_, isList := p.Value.([]interface{})
+		if p.Value != nil && isList != (property.string("value_type") == "multi_select") {
+			writeError(e.w, http.StatusUnprocessableEntity, "Validation Failed")
+			return
+		}
+	}
+
+	for _, p := range req.Properties {
+		if p.Value == nil {
+			delete(r.customProperties, p.PropertyName)
+		} else {
+			r.customProperties[p.PropertyName] = p.Value
+		}
+	}
+	e.noContent()
+}
+
+var slugInvalidCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// teamSlug derives the slug of a team from its name
+func teamSlug(name string) string {
+	return strings.Trim(slugInvalidCharacters.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// teamResponse returns a team as the API returns it
+func (o *organization) teamResponse(t *team) document {
+	response := t.doc.copy()
+	response["members_count"] = len(t.memberships)
+	response["repos_count"] = len(t.repositories)
+	response["organization"] = o.doc
+	return response
+}
+
+// setParent sets the parent of a team from the parent_team_id of a request
+func (o *organization) setParent(e *exchange, t *team, req document) bool {
+	parentID, ok := req["parent_team_id"]
+	if !ok {
+		return true
+	}
+	if parentID == nil {
+		t.doc["parent"] = nil
+		return true
+	}
+
+	id, _ := parentID.(float64)
+	parent, ok := o.teams[int64(id)]
+	if !ok || parent == t {
+		writeError(e.w, http.StatusUnprocessableEntity, "Parent team is invalid")
+		return false
+	}
+	t.doc["parent"] = document{
+		"id":      parent.doc.id(),
+		"node_id": parent.doc.string("node_id"),
+		"name":    parent.doc.string("name"),
+		"slug":    parent.doc.string("slug"),
+	}
+	return true
+}
+
+func (o *organization) teamBySlug(slug string) (*team, bool) {
+	for _, t := range o.teams {
+		if strings.EqualFold(t.doc.string("slug"), slug) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) createTeam(e *exchange, params []string) {
+	org, ok := s.organizations[strings.ToLower(params[0])]
+	if !ok {
+		e.notFound()
+		return
+	}
+
+	var req document
+	if !e.decode(&req) {
+		return
+	}
+
+	name := req.string("name")
+	if _, exists := org.teamBySlug(teamSlug(name)); name == "" || exists {
+		writeError(e.w, http.StatusUnprocessableEntity, "Validation Failed")
+		return
+	}
+
+	id := s.newID()
+	t := &team{
+		doc: document{
+			"id":          id,
+			"node_id":     nodeID("T", id),
+			"name":        name,
+			"slug":        teamSlug(name),
+			"description": req.string("description"),
+			"privacy":     "secret",
+			"permission":  "pull",
+			"parent":      nil,
+			"url":         fmt.Sprintf("%s/api/v3/organizations/%d/team/%d", s.URL, org.doc.id(), id),
+		},
+		// As on GitHub, the creator of a team becomes its maintainer
+		memberships:  map[string]string{strings.ToLower(s.user.string("login")): "maintainer"},
+		repositories: make(map[string]string),
+	}
+	if privacy := req.string("privacy"); privacy != "" {
+		t.doc["privacy"] = privacy
+	}
+	if ldapDN := req.string("ldap_dn"); ldapDN != "" {
+		t.doc["ldap_dn"] = ldapDN
+	}
+	if !org.setParent(e, t, req) {
+		return
+	}
+
+	org.teams[id] = t
+	e.write(http.StatusCreated, org.teamResponse(t))
+}
+
+// team finds the team addressed by the organization and team IDs of a path
+func (s *Server) team(e *exchange, orgID, teamID string) (*organization, *team, bool) {
+	org, ok := s.organizationByID(orgID)
+	if !ok {
+		e.notFound()
+		return nil, nil, false
+	}
+
+	id, err := strconv.ParseInt(teamID, 10, 64)
+	if err != nil {
+		e.notFound()
+		return nil, nil, false
+	}
+	t, ok := org.teams[id]
+	if !ok {
+		e.notFound()
+		return nil, nil, false
+	}
+	return org, t, true
+}
+
+func (s *Server) getTeamBySlug(e *exchange, params []string) {
+	org, ok := s.organizations[strings.ToLower(params[0])]
+	if !ok {
+		e.notFound()
+		return
+	}
+	t, ok := org.teamBySlug(params[1])
+	if !ok {
+		e.notFound()
+		return
+	}
+	e.write(http.StatusOK, org.teamResponse(t))
+}
+
+func (s *Server) getTeam(e *exchange, params []string) {
+	if org, t, ok := s.team(e, params[0], params[1]); ok {
+		e.write(http.StatusOK, org.teamResponse(t))
+	}
+}
+
+func (s *Server) editTeam(e *exchange, params []string) {
+	org, t, ok := s.team(e, params[0], params[1])
+	if !ok {
+		return
+	}
+
+	var req document
+	if !e.decode(&req) {
+		return
+	}
+
+	if name := req.string("name"); name != "" && name != t.doc.string("name") {
+		if _, exists := org.teamBySlug(teamSlug(name)); exists {
+			writeError(e.w, http.StatusUnprocessableEntity, "Validation Failed")
+			return
+		}
+		t.doc["name"] = name
+		t.doc["slug"] = teamSlug(name)
+	}
+	for _, field := range []string{"description", "privacy", "permission"} {
+		if value, ok := req[field]; ok {
+			t.doc[field] = value
+		}
+	}
+	if !org.setParent(e, t, req) {
+		return
+	}
+
+	e.write(http.StatusOK, org.teamResponse(t))
+}
+
+func (s *Server) deleteTeam(e *exchange, params []string) {
+	org, t, ok := s.team(e, params[0], params[1])
+	if !ok {
+		return
+	}
+	org.deleteTeam(t)
+	e.noContent()
+}
+
+// deleteTeam deletes a team along with its child teams, as GitHub does
+func (o *organization) deleteTeam(t *team) {
+	delete(o.teams, t.doc.id())
+	for _, child := range o.teams {
+		if parent, ok := child.doc["parent"].(document); ok && parent.id() == t.doc.id() {
+			o.deleteTeam(child)
+		}
+	}
+}
+
+func (s *Server) teamMembership(t *team, login string) document {
+	return document{
+		"url":   fmt.Sprintf("%s/memberships/%s", t.doc.string("url"), login),
+		"role":  t.memberships[strings.ToLower(login)],
+		"state": "active",
+	}
+}
+
+func (s *Server) getTeamMembership(e *exchange, params []string) {
+	_, t, ok := s.team(e, params[0], params[1])
+	if !ok {
+		return
+	}
+	if _, ok := t.memberships[strings.ToLower(params[2])]; !ok {
+		e.notFound()
+		return
+	}
+	e.write(http.StatusOK, s.teamMembership(t, params[2]))
+}
+
+func (s *Server) setTeamMembership(e *exchange, params []string) {
+	_, t, ok := s.team(e, params[0], params[1])
+	if !ok {
+		return
+	}
+	if _, ok := s.users[strings.ToLower(params[2])]; !ok {
+		e.notFound()
+		return
+	}
+
+	var req struct {
+		Role string `json:"role"`
+	}
+	if !e.decode(&req) {
+		return
+	}
+	if req.Role == "" {
+		req.Role = "member"
+	}
+
+	t.memberships[strings.ToLower(params[2])] = req.Role
+	e.write(http.StatusOK, s.teamMembership(t, params[2]))
+}
+
+func (s *Server) removeTeamMembership(e *exchange, params []string) {
+	if _, t, ok := s.team(e, params[0], params[1]); ok {
+		removeTeamMember(e, t, params[2])
+	}
+}
+
+func (s *Server) removeTeamMembershipBySlug(e *exchange, params []string) {
+	org, ok := s.organizations[strings.ToLower(params[0])]
+	if !ok {
+		e.notFound()
+		return
+	}
+	t, ok := org.teamBySlug(params[1])
+	if !ok {
+		e.notFound()
+		return
+	}
+	removeTeamMember(e, t, params[2])
+}
+
+func removeTeamMember(e *exchange, t *team, login string) {
+	if _, ok := t.memberships[strings.ToLower(login)]; !ok {
+		e.notFound()
+		return
+	}
+	delete(t.memberships, strings.ToLower(login))
+	e.noContent()
+}
+
+func (s *Server) getTeamRepository(e *exchange, params []string) {
+	_, t, ok := s.team(e, params[0], params[1])
+	if !ok {
+		return
+	}
+
+	key := repositoryKey(params[2], params[3])
+	permission, ok := t.repositories[key]
+	r, exists := s.repositories[key]
+	if !ok || !exists {
+		e.notFound()
+		return
+	}
+
+	response := r.doc.copy()
+	response["role_name"] = permission
+	response["permissions"] = document{
+		"pull":     true,
+		"triage":   permission != "pull",
+		"push":     permission == "push" || permission == "maintain" || permission == "admin",
+		"maintain": permission == "maintain" || permission == "admin",
+		"admin":    permission == "admin",
+	}
+	e.write(http.StatusOK, response)
+}
+
+func (s *Server) setTeamRepository(e *exchange, params []string) {
+	_, t, ok := s.team(e, params[0], params[1])
+	if !ok {
+		return
+	}
+	if _, ok := s.repository(e, params[2], params[3]); !ok {
+		return
+	}
+
+	var req struct {
+		Permission string `json:"permission"`
+	}
+	if !e.decode(&req) {
+		return
+	}
+	if req.Permission == "" {
+		req.Permission = "pull"
+	}
+
+	t.repositories[repositoryKey(params[2], params[3])] = req.Permission
+	e.noContent()
+}
+
+func (s *Server) removeTeamRepository(e *exchange, params []string) {
+	if _, t, ok := s.team(e, params[0], params[1]); ok {
+		delete(t.repositories, repositoryKey(params[2], params[3]))
+		e.noContent()
+	}
+}
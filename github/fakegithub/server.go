@@ -0,0 +1,411 @@
+// Package fakegithub provides an in-process fake of the GitHub REST and
+// GraphQL APIs, keeping repositories, teams, memberships, branches, Actions
+// secrets, webhooks, rulesets, custom properties and Projects (v2) in memory.
+// It implements the endpoints used by the resources of the provider closely
+// enough to run their CRUD and import through resource.UnitTest without
+// reaching GitHub.
+package fakegithub
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/nacl/box"
+)
+
+const (
+	// DefaultOrganization is the organization the server is created with
+	DefaultOrganization = "fake-org"
+	// DefaultUser is the user every request is authenticated as
+	DefaultUser = "fake-user"
+)
+
+// Server is a fake GitHub Enterprise Server. Point the base_url of the
+// provider at URL(); any token is accepted.
+type Server struct {
+	*httptest.Server
+
+	routes []route
+
+	m             sync.Mutex
+	nextID        int64
+	user          document
+	users         map[string]document
+	organizations map[string]*organization
+	repositories  map[string]*repository
+	projects      map[string]*project
+
+	// requests counts the requests served by method and path
+	requests map[string]int
+
+	// publicKey and privateKey are the sealed box key pair Actions secrets are
+	// encrypted with
+	publicKey  *[32]byte
+	privateKey *[32]byte
+}
+
+type organization struct {
+	doc         document
+	memberships map[string]string
+	teams       map[int64]*team
+	rulesets    map[int64]document
+
+	// customProperties is the custom property schema by property name
+	customProperties map[string]document
+}
+
+type team struct {
+	doc          document
+	memberships  map[string]string
+	repositories map[string]string
+}
+
+type repository struct {
+	doc                 document
+	branches            map[string]string
+	hooks               map[int64]document
+	rulesets            map[int64]document
+	secrets             map[string]*secret
+	customProperties    map[string]interface{}
+	vulnerabilityAlerts bool
+}
+
+type secret struct {
+	encryptedValue string
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// NewServer starts a fake server knowing DefaultUser, who is an admin of
+// DefaultOrganization. The caller must Close it.
+func NewServer() *Server {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(fmt.Sprintf("fakegithub: unable to generate a key pair: %s", err))
+	}
+
+	s := &Server{
+		nextID:        1,
+		users:         make(map[string]document),
+		organizations: make(map[string]*organization),
+		repositories:  make(map[string]*repository),
+		projects:      make(map[string]*project),
+		requests:      make(map[string]int),
+		publicKey:     publicKey,
+		privateKey:    privateKey,
+	}
+	s.user = s.AddUser(DefaultUser)
+	s.AddOrganization(DefaultOrganization)
+
+	s.routes = s.restRoutes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Requests returns how many requests for path, without the API prefix and
+// query, were served with method
+func (s *Server) Requests(method, path string) int {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.requests[method+" "+path]
+}
+
+// BaseURL returns the URL to configure as the base_url of the provider
+func (s *Server) BaseURL() string {
+	return s.URL + "/"
+}
+
+// AddUser adds a user which can be invited to organizations and teams
+func (s *Server) AddUser(login string) document {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if user, ok := s.users[strings.ToLower(login)]; ok {
+		return user
+	}
+
+	id := s.newID()
+	user := document{
+		"login":   login,
+		"id":      id,
+		"node_id": nodeID("U", id),
+		"type":    "User",
+	}
+	s.users[strings.ToLower(login)] = user
+	return user
+}
+
+// AddOrganization adds an organization administered by DefaultUser
+func (s *Server) AddOrganization(login string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	id := s.newID()
+	s.organizations[strings.ToLower(login)] = &organization{
+		doc: document{
+			"login":   login,
+			"id":      id,
+			"node_id": nodeID("O", id),
+			"type":    "Organization",
+		},
+		memberships: map[string]string{strings.ToLower(DefaultUser): "admin"},
+		teams:       make(map[int64]*team),
+		rulesets:    make(map[int64]document),
+
+		customProperties: make(map[string]document),
+	}
+}
+
+// SecretValue decrypts the value of an Actions secret of a repository, which
+// proves the provider sealed it with the public key of the repository.
+func (s *Server) SecretValue(owner, repo, name string) (string, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	r, ok := s.repositories[repositoryKey(owner, repo)]
+	if !ok {
+		return "", fmt.Errorf("no repository %s/%s", owner, repo)
+	}
+	sec, ok := r.secrets[name]
+	if !ok {
+		return "", fmt.Errorf("no secret %s in %s/%s", name, owner, repo)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(sec.encryptedValue)
+	if err != nil {
+		return "", err
+	}
+	plaintext, ok := box.OpenAnonymous(nil, sealed, s.publicKey, s.privateKey)
+	if !ok {
+		return "", fmt.Errorf("secret %s in %s/%s is not sealed with the repository public key", name, owner, repo)
+	}
+	return string(plaintext), nil
+}
+
+// HasRepository tells whether a repository exists
+func (s *Server) HasRepository(owner, repo string) bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	_, ok := s.repositories[repositoryKey(owner, repo)]
+	return ok
+}
+
+// DeleteRepository removes a repository behind the back of the provider, e.g.
+// to exercise the handling of drift.
+func (s *Server) DeleteRepository(owner, repo string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	delete(s.repositories, repositoryKey(owner, repo))
+}
+
+// newID returns a new ID, unique across all objects of the server. Callers
+// must hold the lock.
+func (s *Server) newID() int64 {
+	id := s.nextID
+	s.nextID++
+	return id
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/api/v3")
+	if path == "/api/graphql" || path == "/graphql" {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		s.serveGraphQL(w, r)
+		return
+	}
+
+	for _, rt := range s.routes {
+		if rt.method != r.Method {
+			continue
+		}
+		if match := rt.pattern.FindStringSubmatch(path); match != nil {
+			s.m.Lock()
+			defer s.m.Unlock()
+			s.requests[r.Method+" "+path]++
+			rt.handler(&exchange{w: w, r: r}, match[1:])
+			return
+		}
+	}
+
+	log.Printf("[WARN] fakegithub: no route for %s %s", r.Method, r.URL.Path)
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+// route maps a method and a path to a handler, which is passed the submatches
+// of the path pattern and called holding the server lock.
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	handler func(e *exchange, params []string)
+}
+
+func newRoute(method, pattern string, handler func(e *exchange, params []string)) route {
+	return route{method: method, pattern: regexp.MustCompile("^" + pattern + "$"), handler: handler}
+}
+
+// exchange wraps a request and its response
+type exchange struct {
+	w http.ResponseWriter
+	r *http.Request
+}
+
+// decode reads the JSON body of the request
+func (e *exchange) decode(v interface{}) bool {
+	if err := json.NewDecoder(e.r.Body).Decode(v); err != nil {
+		writeError(e.w, http.StatusBadRequest, "Problems parsing JSON")
+		return false
+	}
+	return true
+}
+
+// write answers with v as JSON, or with 304 Not Modified when the client
+// already holds the same representation.
+func (e *exchange) write(status int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		writeError(e.w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	etag := fmt.Sprintf(`W/"%x"`, sha256.Sum256(body))
+	e.w.Header().Set("ETag", etag)
+	if e.r.Method == http.MethodGet && e.r.Header.Get("If-None-Match") == etag {
+		e.w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	e.w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	e.w.WriteHeader(status)
+	_, _ = e.w.Write(body)
+}
+
+// writePage answers with the page of items requested by the page and
+// per_page query parameters, linking to the next and last pages like GitHub.
+func (e *exchange) writePage(items []interface{}) {
+	query := e.r.URL.Query()
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = 30
+	}
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	start := (page - 1) * perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	lastPage := (len(items) + perPage - 1) / perPage
+	if page < lastPage {
+		link := func(page int, rel string) string {
+			u := *e.r.URL
+			u.Scheme = "http"
+			u.Host = e.r.Host
+			q := u.Query()
+			q.Set("page", strconv.Itoa(page))
+			u.RawQuery = q.Encode()
+			return fmt.Sprintf(`<%s>; rel="%s"`, u.String(), rel)
+		}
+		e.w.Header().Set("Link", link(page+1, "next")+", "+link(lastPage, "last"))
+	}
+
+	e.write(http.StatusOK, items[start:end])
+}
+
+func (e *exchange) noContent() {
+	e.w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *exchange) notFound() {
+	writeError(e.w, http.StatusNotFound, "Not Found")
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	body, _ := json.Marshal(map[string]interface{}{
+		"message":           message,
+		"documentation_url": "https://docs.github.com/rest",
+	})
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// document is a JSON object as returned by the API
+type document map[string]interface{}
+
+// copy returns a deep copy of d, so that handlers never hand out state
+func (d document) copy() document {
+	var c document
+	data, _ := json.Marshal(d)
+	_ = json.Unmarshal(data, &c)
+	return c
+}
+
+// merge sets the fields of update on d, except for the ignored ones
+func (d document) merge(update document, ignored ...string) {
+	for k, v := range update {
+		if !contains(ignored, k) {
+			d[k] = v
+		}
+	}
+}
+
+func (d document) string(field string) string {
+	s, _ := d[field].(string)
+	return s
+}
+
+func (d document) id() int64 {
+	switch id := d["id"].(type) {
+	case int64:
+		return id
+	case float64:
+		return int64(id)
+	}
+	return 0
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeID builds a GraphQL node ID for an object, which the server resolves
+// back through the node query.
+func nodeID(prefix string, id int64) string {
+	return fmt.Sprintf("%s_%d", prefix, id)
+}
+
+func repositoryKey(owner, repo string) string {
+	return strings.ToLower(owner + "/" + repo)
+}
+
+func timestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -20,14 +20,16 @@ func resourceGithubBranchProtectionV0() *schema.Resource {
 }
 
 func resourceGithubBranchProtectionUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	ctx := stopContext(meta)
+
 	repoName := rawState["repository"].(string)
-	repoID, err := getRepositoryID(repoName, meta)
+	repoID, err := getRepositoryID(ctx, repoName, meta)
 	if err != nil {
 		return nil, err
 	}
 
 	branch := rawState["branch"].(string)
-	protectionRuleID, err := getBranchProtectionID(repoID, branch, meta)
+	protectionRuleID, err := getBranchProtectionID(ctx, repoID, branch, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -3,11 +3,14 @@ package github
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"os"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
 
@@ -45,6 +48,54 @@ func Provider() terraform.ResourceProvider {
 				Default:     false,
 				Description: descriptions["insecure"],
 			},
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITHUB_CA_CERT_FILE", nil),
+				Description: descriptions["ca_cert_file"],
+			},
+			"ca_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["ca_cert_pem"],
+			},
+			"client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_key"},
+				Description:  descriptions["client_cert"],
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client_cert"},
+				Description:  descriptions["client_key"],
+			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITHUB_API_VERSION", defaultAPIVersion),
+				Description: descriptions["api_version"],
+			},
+			"extra_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["extra_headers"],
+			},
+			"validate_permissions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["validate_permissions"],
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: boolEnvDefaultFunc("GITHUB_READ_ONLY", false),
+				Description: descriptions["read_only"],
+			},
 			"write_delay_ms": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -63,6 +114,78 @@ func Provider() terraform.ResourceProvider {
 				Default:     false,
 				Description: descriptions["parallel_requests"],
 			},
+			"max_concurrent_reads": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: descriptions["max_concurrent_reads"],
+			},
+			"rate_limit_threshold": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: descriptions["rate_limit_threshold"],
+			},
+			"rate_limit_reserve": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: descriptions["rate_limit_reserve"],
+			},
+			"cache_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["cache_enabled"],
+			},
+			"cache_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITHUB_CACHE_DIR", nil),
+				Description: descriptions["cache_dir"],
+			},
+			"audit_log_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITHUB_AUDIT_LOG_FILE", nil),
+				Description: descriptions["audit_log_file"],
+			},
+			"log_redact_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+				Description: descriptions["log_redact_patterns"],
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3,
+				Description: descriptions["max_retries"],
+			},
+			"retry_delay_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000,
+				Description: descriptions["retry_delay_ms"],
+			},
+			"max_retry_delay_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30000,
+				Description: descriptions["max_retry_delay_ms"],
+			},
+			"retry_jitter": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: descriptions["retry_jitter"],
+			},
+			"retryable_status_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: descriptions["retryable_status_codes"],
+			},
 			"app_auth": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -78,7 +201,7 @@ func Provider() terraform.ResourceProvider {
 						},
 						"installation_id": {
 							Type:        schema.TypeString,
-							Required:    true,
+							Optional:    true,
 							DefaultFunc: schema.EnvDefaultFunc("GITHUB_APP_INSTALLATION_ID", nil),
 							Description: descriptions["app_auth.installation_id"],
 						},
@@ -125,18 +248,24 @@ func Provider() terraform.ResourceProvider {
 			"github_issue_label":                                                    resourceGithubIssueLabel(),
 			"github_membership":                                                     resourceGithubMembership(),
 			"github_organization_block":                                             resourceOrganizationBlock(),
+			"github_organization_custom_property":                                   resourceGithubOrganizationCustomProperty(),
 			"github_organization_custom_role":                                       resourceGithubOrganizationCustomRole(),
 			"github_organization_project":                                           resourceGithubOrganizationProject(),
+			"github_organization_ruleset":                                           resourceGithubOrganizationRuleset(),
 			"github_organization_security_manager":                                  resourceGithubOrganizationSecurityManager(),
 			"github_organization_settings":                                          resourceGithubOrganizationSettings(),
 			"github_organization_webhook":                                           resourceGithubOrganizationWebhook(),
 			"github_project_card":                                                   resourceGithubProjectCard(),
 			"github_project_column":                                                 resourceGithubProjectColumn(),
+			"github_project_v2":                                                     resourceGithubProjectV2(),
+			"github_project_v2_field":                                               resourceGithubProjectV2Field(),
+			"github_project_v2_item":                                                resourceGithubProjectV2Item(),
 			"github_release":                                                        resourceGithubRelease(),
 			"github_repository":                                                     resourceGithubRepository(),
 			"github_repository_autolink_reference":                                  resourceGithubRepositoryAutolinkReference(),
 			"github_repository_collaborator":                                        resourceGithubRepositoryCollaborator(),
 			"github_repository_collaborators":                                       resourceGithubRepositoryCollaborators(),
+			"github_repository_custom_property":                                     resourceGithubRepositoryCustomProperty(),
 			"github_repository_deploy_key":                                          resourceGithubRepositoryDeployKey(),
 			"github_repository_deployment_branch_policy":                            resourceGithubRepositoryDeploymentBranchPolicy(),
 			"github_repository_environment":                                         resourceGithubRepositoryEnvironment(),
@@ -145,6 +274,7 @@ func Provider() terraform.ResourceProvider {
 			"github_repository_milestone":                                           resourceGithubRepositoryMilestone(),
 			"github_repository_project":                                             resourceGithubRepositoryProject(),
 			"github_repository_pull_request":                                        resourceGithubRepositoryPullRequest(),
+			"github_repository_ruleset":                                             resourceGithubRepositoryRuleset(),
 			"github_repository_tag_protection":                                      resourceGithubRepositoryTagProtection(),
 			"github_repository_webhook":                                             resourceGithubRepositoryWebhook(),
 			"github_team":                                                           resourceGithubTeam(),
@@ -176,6 +306,7 @@ func Provider() terraform.ResourceProvider {
 			"github_app_token":                                                      dataSourceGithubAppToken(),
 			"github_branch":                                                         dataSourceGithubBranch(),
 			"github_branch_protection_rules":                                        dataSourceGithubBranchProtectionRules(),
+			"github_branch_rules":                                                   dataSourceGithubBranchRules(),
 			"github_collaborators":                                                  dataSourceGithubCollaborators(),
 			"github_codespaces_organization_public_key":                             dataSourceGithubCodespacesOrganizationPublicKey(),
 			"github_codespaces_organization_secrets":                                dataSourceGithubCodespacesOrganizationSecrets(),
@@ -195,9 +326,12 @@ func Provider() terraform.ResourceProvider {
 			"github_organization_custom_role":                                       dataSourceGithubOrganizationCustomRole(),
 			"github_organization_external_identities":                               dataSourceGithubOrganizationExternalIdentities(),
 			"github_organization_ip_allow_list":                                     dataSourceGithubOrganizationIpAllowList(),
+			"github_organization_rule_suites":                                       dataSourceGithubOrganizationRuleSuites(),
+			"github_organization_rulesets":                                          dataSourceGithubOrganizationRulesets(),
 			"github_organization_team_sync_groups":                                  dataSourceGithubOrganizationTeamSyncGroups(),
 			"github_organization_teams":                                             dataSourceGithubOrganizationTeams(),
 			"github_organization_webhooks":                                          dataSourceGithubOrganizationWebhooks(),
+			"github_project_v2":                                                     dataSourceGithubProjectV2(),
 			"github_ref":                                                            dataSourceGithubRef(),
 			"github_release":                                                        dataSourceGithubRelease(),
 			"github_repositories":                                                   dataSourceGithubRepositories(),
@@ -211,6 +345,8 @@ func Provider() terraform.ResourceProvider {
 			"github_repository_milestone":                                           dataSourceGithubRepositoryMilestone(),
 			"github_repository_pull_request":                                        dataSourceGithubRepositoryPullRequest(),
 			"github_repository_pull_requests":                                       dataSourceGithubRepositoryPullRequests(),
+			"github_repository_rule_suites":                                         dataSourceGithubRepositoryRuleSuites(),
+			"github_repository_rulesets":                                            dataSourceGithubRepositoryRulesets(),
 			"github_repository_teams":                                               dataSourceGithubRepositoryTeams(),
 			"github_repository_webhooks":                                            dataSourceGithubRepositoryWebhooks(),
 			"github_rest_api":                                                       dataSourceGithubRestApi(),
@@ -223,6 +359,20 @@ func Provider() terraform.ResourceProvider {
 		},
 	}
 
+	for name, r := range p.ResourcesMap {
+		setDefaultTimeouts(r)
+		if ownerOverrideSupported[name] {
+			setOwnerOverride(r, false)
+		}
+		setResourceType(name, r)
+	}
+	for name, r := range p.DataSourcesMap {
+		if ownerOverrideSupported["data."+name] {
+			setOwnerOverride(r, true)
+		}
+		setResourceType("data."+name, r)
+	}
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -239,6 +389,21 @@ func init() {
 
 		"insecure": "Enable `insecure` mode for testing purposes",
 
+		"ca_cert_file": "Path to a PEM encoded file of additional certificate authorities to trust " +
+			"when connecting to GitHub.",
+
+		"ca_cert_pem": "PEM encoded additional certificate authorities to trust when connecting to GitHub.",
+
+		"client_cert": "PEM encoded client certificate presented to GitHub for mutual TLS. Requires `client_key`.",
+
+		"client_key": "PEM encoded private key of the client certificate. Requires `client_cert`.",
+
+		"api_version": "The GitHub REST API version sent in the `X-GitHub-Api-Version` header of every REST call. " +
+			"Defaults to " + defaultAPIVersion + ". Set to an empty string to not send the header.",
+
+		"extra_headers": "Additional HTTP headers sent with every request to GitHub, " +
+			"for example routing headers required by a gateway in front of GitHub Enterprise Server.",
+
 		"owner": "The GitHub owner name to manage. " +
 			"Use this field instead of `organization` when managing individual accounts.",
 
@@ -250,6 +415,10 @@ func init() {
 		"app_auth.id":              "The GitHub App ID.",
 		"app_auth.installation_id": "The GitHub App installation instance ID.",
 		"app_auth.pem_file":        "The GitHub App PEM file contents.",
+		"validate_permissions": "Probe GitHub with the configured credentials at configure time " +
+			"and report missing scopes or permissions. Defaults to false.",
+		"read_only": "Reject every request which could modify data on GitHub, such as POST, PATCH, PUT, " +
+			"DELETE and GraphQL mutations. Useful for plan-only runs such as drift detection. Defaults to false.",
 		"write_delay_ms": "Amount of time in milliseconds to sleep in between writes to GitHub API. " +
 			"Defaults to 1000ms or 1s if not set.",
 		"read_delay_ms": "Amount of time in milliseconds to sleep in between non-write requests to GitHub API. " +
@@ -259,6 +428,30 @@ func init() {
 			"Although, it is not possible to enable this setting on github.com " +
 			"because we enforce the respect of github.com's best practices to avoid hitting abuse rate limits" +
 			"Defaults to false if not set",
+		"max_concurrent_reads": "Number of read requests the provider may send to GitHub at the same time " +
+			"while write requests remain serialized. Can be used on github.com. Defaults to 1.",
+		"rate_limit_threshold": "Number of remaining requests in the GitHub API rate limit below which the provider " +
+			"spreads its requests evenly until the rate limit resets. Set to 0 to disable. Defaults to 100.",
+		"rate_limit_reserve": "Number of requests in the GitHub API rate limit left unused for other clients " +
+			"sharing the same credentials. Defaults to 0.",
+		"cache_enabled": "Cache GitHub API responses and revalidate them with conditional requests, " +
+			"which do not count against the rate limit when nothing changed. Defaults to false.",
+		"cache_dir": "Directory in which cached GitHub API responses are persisted between runs. " +
+			"Responses are kept for 7 days. When not set, responses are only cached in memory. Requires `cache_enabled`.",
+		"audit_log_file": "File to which every GitHub API call is appended as a line of JSON, " +
+			"with a summary of the calls per resource type kept next to it in a `.summary.json` file. " +
+			"Request bodies are left out for the secrets endpoints.",
+		"log_redact_patterns": "Regular expressions matching further sensitive values to redact from the requests " +
+			"and responses logged at the DEBUG level, in addition to the known credential headers, fields and token formats.",
+		"max_retries": "Number of times a request failing with a retryable status code or a transient network error " +
+			"is retried. Set to 0 to disable retries. Defaults to 3.",
+		"retry_delay_ms": "Amount of time in milliseconds to wait before the first retry. " +
+			"The delay doubles with every further retry. Defaults to 1000ms or 1s if not set.",
+		"max_retry_delay_ms": "Maximum amount of time in milliseconds to wait between retries. " +
+			"Defaults to 30000ms or 30s if not set.",
+		"retry_jitter": "Randomize the delay between retries to spread out retrying clients. Defaults to true.",
+		"retryable_status_codes": "HTTP status codes of GitHub API responses which are retried. " +
+			"Defaults to 500, 502, 503 and 504; rate limited requests are waited out separately.",
 	}
 }
 
@@ -291,6 +484,45 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			owner = org
 		}
 
+		var appTokenSource *appInstallationTokenSource
+		caCertPEM := d.Get("ca_cert_pem").(string)
+		if caCertFile := d.Get("ca_cert_file").(string); caCertFile != "" {
+			data, err := os.ReadFile(caCertFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read ca_cert_file: %s", err)
+			}
+			caCertPEM = strings.Join([]string{caCertPEM, string(data)}, "\n")
+		}
+
+		tlsConfig, err := NewTLSConfig(caCertPEM, d.Get("client_cert").(string), d.Get("client_key").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		apiVersion := d.Get("api_version").(string)
+
+		extraHeaders := make(map[string]string)
+		for name, value := range d.Get("extra_headers").(map[string]interface{}) {
+			if strings.EqualFold(name, "Authorization") {
+				return nil, fmt.Errorf("extra_headers cannot set the Authorization header")
+			}
+			extraHeaders[name] = value.(string)
+		}
+
+		var logRedactPatterns []*regexp.Regexp
+		for _, v := range d.Get("log_redact_patterns").([]interface{}) {
+			pattern, err := regexp.Compile(v.(string))
+			if err != nil {
+				return nil, fmt.Errorf("invalid log_redact_patterns entry %q: %s", v, err)
+			}
+			logRedactPatterns = append(logRedactPatterns, pattern)
+		}
+
+		// GitHub App token exchanges go through the same TLS settings,
+		// headers and redacted logging as every other API call
+		appClient := &http.Client{Transport: newHeaderInjectorTransport(nil, extraHeaders, apiVersion,
+			NewLoggingTransport("GitHub", newHTTPTransport(tlsConfig, insecure), logRedactPatterns...))}
+
 		if appAuth, ok := d.Get("app_auth").([]interface{}); ok && len(appAuth) > 0 && appAuth[0] != nil {
 			appAuthAttr := appAuth[0].(map[string]interface{})
 
@@ -304,8 +536,6 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 
 			if v, ok := appAuthAttr["installation_id"].(string); ok && v != "" {
 				appInstallationID = v
-			} else {
-				return nil, fmt.Errorf("app_auth.installation_id must be set and contain a non-empty value")
 			}
 
 			if v, ok := appAuthAttr["pem_file"].(string); ok && v != "" {
@@ -321,12 +551,26 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 				return nil, fmt.Errorf("app_auth.pem_file must be set and contain a non-empty value")
 			}
 
-			appToken, err := GenerateOAuthTokenFromApp(baseURL, appID, appInstallationID, appPemFile)
-			if err != nil {
-				return nil, err
+			if appInstallationID == "" {
+				if owner == "" {
+					return nil, fmt.Errorf("app_auth.installation_id must be set when no owner is configured")
+				}
+
+				installationID, err := GetAppInstallationIDForOwner(appClient, baseURL, appID, owner, appPemFile)
+				if err != nil {
+					return nil, err
+				}
+				log.Printf("[INFO] Discovered GitHub App installation %s for owner %s", installationID, owner)
+				appInstallationID = installationID
 			}
 
-			token = appToken
+			appTokenSource = newAppInstallationTokenSource(appClient, baseURL, appID, appInstallationID, appPemFile)
+
+			// Mint the first installation token right away so that invalid
+			// credentials are reported at configure time
+			if _, err := appTokenSource.Token(); err != nil {
+				return nil, err
+			}
 		}
 
 		writeDelay := d.Get("write_delay_ms").(int)
@@ -352,14 +596,100 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		}
 		log.Printf("[DEBUG] Setting parallel_requests to %t", parallelRequests)
 
+		maxConcurrentReads := d.Get("max_concurrent_reads").(int)
+		if maxConcurrentReads < 1 {
+			return nil, fmt.Errorf("max_concurrent_reads must be greater than or equal to 1")
+		}
+		log.Printf("[DEBUG] Setting max_concurrent_reads to %d", maxConcurrentReads)
+
+		rateLimitThreshold := d.Get("rate_limit_threshold").(int)
+		if rateLimitThreshold < 0 {
+			return nil, fmt.Errorf("rate_limit_threshold must be greater than or equal to 0")
+		}
+
+		rateLimitReserve := d.Get("rate_limit_reserve").(int)
+		if rateLimitReserve < 0 {
+			return nil, fmt.Errorf("rate_limit_reserve must be greater than or equal to 0")
+		}
+		if rateLimitReserve > 0 && rateLimitReserve >= rateLimitThreshold {
+			return nil, fmt.Errorf("rate_limit_reserve must be lower than rate_limit_threshold")
+		}
+		log.Printf("[DEBUG] Setting rate_limit_threshold to %d and rate_limit_reserve to %d", rateLimitThreshold, rateLimitReserve)
+
+		maxRetries := d.Get("max_retries").(int)
+		if maxRetries < 0 {
+			return nil, fmt.Errorf("max_retries must be greater than or equal to 0")
+		}
+
+		retryDelay := d.Get("retry_delay_ms").(int)
+		if retryDelay <= 0 {
+			return nil, fmt.Errorf("retry_delay_ms must be greater than 0ms")
+		}
+
+		maxRetryDelay := d.Get("max_retry_delay_ms").(int)
+		if maxRetryDelay < retryDelay {
+			return nil, fmt.Errorf("max_retry_delay_ms must be greater than or equal to retry_delay_ms")
+		}
+		log.Printf("[DEBUG] Setting max_retries to %d with delays between %dms and %dms", maxRetries, retryDelay, maxRetryDelay)
+
+		var retryableStatusCodes []int
+		for _, v := range d.Get("retryable_status_codes").([]interface{}) {
+			retryableStatusCodes = append(retryableStatusCodes, v.(int))
+		}
+
+		var responseCache ResponseCache
+		if d.Get("cache_enabled").(bool) {
+			if cacheDir := d.Get("cache_dir").(string); cacheDir != "" {
+				responseCache, err = NewDiskResponseCache(cacheDir)
+				if err != nil {
+					return nil, fmt.Errorf("unable to use cache_dir: %s", err)
+				}
+				log.Printf("[DEBUG] Caching responses in %s", cacheDir)
+			} else {
+				responseCache = NewMemoryResponseCache()
+				log.Printf("[DEBUG] Caching responses in memory")
+			}
+		}
+
+		var auditLog *AuditLog
+		if auditLogFile := d.Get("audit_log_file").(string); auditLogFile != "" {
+			auditLog, err = OpenAuditLog(auditLogFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to open audit_log_file: %s", err)
+			}
+			log.Printf("[INFO] Writing an audit log of GitHub API calls to %s", auditLogFile)
+		}
+
+		readOnly := d.Get("read_only").(bool)
+		if readOnly {
+			log.Printf("[INFO] read_only is set; requests modifying data on GitHub will be rejected")
+		}
+
 		config := Config{
-			Token:            token,
-			BaseURL:          baseURL,
-			Insecure:         insecure,
-			Owner:            owner,
-			WriteDelay:       time.Duration(writeDelay) * time.Millisecond,
-			ReadDelay:        time.Duration(readDelay) * time.Millisecond,
-			ParallelRequests: parallelRequests,
+			Token:                token,
+			BaseURL:              baseURL,
+			Insecure:             insecure,
+			TLSConfig:            tlsConfig,
+			APIVersion:           apiVersion,
+			ExtraHeaders:         extraHeaders,
+			Owner:                owner,
+			WriteDelay:           time.Duration(writeDelay) * time.Millisecond,
+			ReadDelay:            time.Duration(readDelay) * time.Millisecond,
+			ParallelRequests:     parallelRequests,
+			MaxConcurrentReads:   maxConcurrentReads,
+			RateLimitThreshold:   rateLimitThreshold,
+			RateLimitReserve:     rateLimitReserve,
+			MaxRetries:           maxRetries,
+			RetryDelay:           time.Duration(retryDelay) * time.Millisecond,
+			MaxRetryDelay:        time.Duration(maxRetryDelay) * time.Millisecond,
+			RetryJitter:          d.Get("retry_jitter").(bool),
+			RetryableStatusCodes: retryableStatusCodes,
+			ResponseCache:        responseCache,
+			AuditLog:             auditLog,
+			LogRedactPatterns:    logRedactPatterns,
+			ReadOnly:             readOnly,
+			ValidatePermissions:  d.Get("validate_permissions").(bool),
+			AppTokenSource:       appTokenSource,
 		}
 
 		meta, err := config.Meta()
@@ -0,0 +1,895 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/integrations/terraform-provider-github/v5/github/fakegithub"
+)
+
+// testFakeProviderConfig returns the configuration of a provider managing
+// the default organization of a fake server
+func testFakeProviderConfig(server *fakegithub.Server) string {
+	return fmt.Sprintf(`
+		provider "github" {
+			base_url       = "%s"
+			token          = "fake-token"
+			owner          = "%s"
+			write_delay_ms = 1
+		}
+	`, server.BaseURL(), fakegithub.DefaultOrganization)
+}
+
+func TestGithubRepositoryFake(t *testing.T) {
+	server := fakegithub.NewServer()
+	defer server.Close()
+
+	config := testFakeProviderConfig(server) + `
+		resource "github_repository" "test" {
+			name                 = "tf-unit-test"
+			description          = "%s"
+			topics               = ["terraform"]
+			auto_init            = true
+			vulnerability_alerts = true
+		}
+
+		resource "github_branch" "test" {
+			repository = github_repository.test.name
+			branch     = "feature"
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		CheckDestroy: func(*terraform.State) error {
+			if server.HasRepository(fakegithub.DefaultOrganization, "tf-unit-test") {
+				return fmt.Errorf("repository tf-unit-test still exists")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, "created"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository.test", "full_name", fakegithub.DefaultOrganization+"/tf-unit-test"),
+					resource.TestCheckResourceAttr("github_repository.test", "description", "created"),
+					resource.TestCheckResourceAttr("github_repository.test", "topics.#", "1"),
+					resource.TestCheckResourceAttr("github_repository.test", "vulnerability_alerts", "true"),
+					resource.TestCheckResourceAttr("github_branch.test", "ref", "refs/heads/feature"),
+					resource.TestCheckResourceAttrPair("github_branch.test", "sha", "github_branch.test", "source_sha"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, "updated"),
+				Check:  resource.TestCheckResourceAttr("github_repository.test", "description", "updated"),
+			},
+			{
+				Config:                  fmt.Sprintf(config, "updated"),
+				ResourceName:            "github_repository.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_init"},
+			},
+			{
+				Config:                  fmt.Sprintf(config, "updated"),
+				ResourceName:            "github_branch.test",
+				ImportState:             true,
+				ImportStateId:           "tf-unit-test:feature",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_sha"},
+			},
+		},
+	})
+}
+
+func TestGithubTeamFake(t *testing.T) {
+	server := fakegithub.NewServer()
+	defer server.Close()
+	server.AddUser("fake-collaborator")
+
+	config := testFakeProviderConfig(server) + `
+		resource "github_team" "parent" {
+			name = "tf-unit-parent"
+		}
+
+		resource "github_team" "test" {
+			name           = "tf-unit-team"
+			description    = "%s"
+			privacy        = "closed"
+			parent_team_id = github_team.parent.id
+		}
+
+		resource "github_membership" "test" {
+			username = "fake-collaborator"
+		}
+
+		resource "github_team_membership" "test" {
+			team_id  = github_team.test.id
+			username = github_membership.test.username
+			role     = "maintainer"
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, "created"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_team.test", "slug", "tf-unit-team"),
+					resource.TestCheckResourceAttr("github_team.test", "privacy", "closed"),
+					resource.TestCheckResourceAttrPair("github_team.test", "parent_team_id", "github_team.parent", "id"),
+					resource.TestCheckResourceAttr("github_membership.test", "role", "member"),
+					resource.TestCheckResourceAttr("github_team_membership.test", "role", "maintainer"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_team.test", "description", "updated"),
+					// The default maintainer was removed, leaving the one member
+					resource.TestCheckResourceAttr("github_team.test", "members_count", "1"),
+				),
+			},
+			{
+				Config:            fmt.Sprintf(config, "updated"),
+				ResourceName:      "github_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:            fmt.Sprintf(config, "updated"),
+				ResourceName:      "github_team_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGithubOwnerOverrideFake(t *testing.T) {
+	server := fakegithub.NewServer()
+	defer server.Close()
+	server.AddOrganization("other-org")
+
+	// An organization level and a repository level resource managed for
+	// another organization than the one of the provider, referencing each
+	// other by ID
+	config := testFakeProviderConfig(server) + `
+		resource "github_repository" "test" {
+			owner     = "other-org"
+			name      = "tf-unit-test"
+			auto_init = true
+		}
+
+		resource "github_team" "test" {
+			owner = "other-org"
+			name  = "tf-unit-team"
+		}
+
+		resource "github_branch" "test" {
+			owner      = "other-org"
+			repository = github_repository.test.name
+			branch     = "feature"
+		}
+
+		resource "github_team_repository" "test" {
+			owner      = "other-org"
+			team_id    = github_team.test.id
+			repository = github_repository.test.name
+			permission = "push"
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		CheckDestroy: func(*terraform.State) error {
+			if server.HasRepository("other-org", "tf-unit-test") {
+				return fmt.Errorf("repository tf-unit-test of other-org still exists")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						if !server.HasRepository("other-org", "tf-unit-test") {
+							return fmt.Errorf("expected tf-unit-test to be created in other-org")
+						}
+						if server.HasRepository(fakegithub.DefaultOrganization, "tf-unit-test") {
+							return fmt.Errorf("expected tf-unit-test not to be created in %s", fakegithub.DefaultOrganization)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("github_repository.test", "full_name", "other-org/tf-unit-test"),
+					resource.TestCheckResourceAttr("github_repository.test", "id", "tf-unit-test"),
+					resource.TestCheckResourceAttr("github_branch.test", "id", "tf-unit-test:feature"),
+					resource.TestCheckResourceAttr("github_team.test", "slug", "tf-unit-team"),
+					resource.TestCheckResourceAttr("github_team_repository.test", "permission", "push"),
+				),
+			},
+			{
+				Config:                  config,
+				ResourceName:            "github_repository.test",
+				ImportState:             true,
+				ImportStateId:           "other-org@tf-unit-test",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_init"},
+			},
+		},
+	})
+}
+
+func TestGithubActionsSecretAndWebhookFake(t *testing.T) {
+	server := fakegithub.NewServer()
+	defer server.Close()
+
+	config := testFakeProviderConfig(server) + `
+		resource "github_repository" "test" {
+			name = "tf-unit-test"
+		}
+
+		resource "github_actions_secret" "test" {
+			repository      = github_repository.test.name
+			secret_name     = "TEST_SECRET"
+			plaintext_value = "%s"
+		}
+
+		resource "github_repository_webhook" "test" {
+			repository = github_repository.test.name
+			events     = ["push", "pull_request"]
+
+			configuration {
+				url          = "https://example.com/webhook"
+				content_type = "json"
+				secret       = "webhook-secret"
+				insecure_ssl = false
+			}
+		}
+	`
+
+	checkSecret := func(value string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			got, err := server.SecretValue(fakegithub.DefaultOrganization, "tf-unit-test", "TEST_SECRET")
+			if err != nil {
+				return err
+			}
+			if got != value {
+				return fmt.Errorf("expected the secret to be %q, got %q", value, got)
+			}
+			return nil
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, "first value"),
+				Check: resource.ComposeTestCheckFunc(
+					checkSecret("first value"),
+					resource.TestCheckResourceAttrSet("github_actions_secret.test", "created_at"),
+					resource.TestCheckResourceAttr("github_repository_webhook.test", "events.#", "2"),
+					resource.TestCheckResourceAttr("github_repository_webhook.test", "configuration.0.secret", "webhook-secret"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, "second value"),
+				Check:  checkSecret("second value"),
+			},
+			{
+				Config:       fmt.Sprintf(config, "second value"),
+				ResourceName: "github_repository_webhook.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "tf-unit-test/" + s.RootModule().Resources["github_repository_webhook.test"].Primary.ID, nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configuration.0.secret"},
+			},
+		},
+	})
+}
+
+func TestGithubRestApiDataSourceFake(t *testing.T) {
+	server := fakegithub.NewServer()
+	defer server.Close()
+
+	repositories := testFakeProviderConfig(server) + `
+		resource "github_repository" "test" {
+			count = 3
+			name  = "tf-unit-test-${count.index}"
+		}
+	`
+
+	config := repositories + `
+		data "github_rest_api" "paginated" {
+			endpoint = "orgs/%[1]s/repos"
+			paginate = true
+			query = {
+				per_page = 2
+			}
+		}
+
+		data "github_rest_api" "missing" {
+			endpoint = "repos/%[1]s/missing"
+		}
+	`
+
+	checkNames := func(s *terraform.State) error {
+		body := s.RootModule().Resources["data.github_rest_api.paginated"].Primary.Attributes["body"]
+		var repositories []struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal([]byte(body), &repositories); err != nil {
+			return fmt.Errorf("expected a JSON array as body, got %s: %s", body, err)
+		}
+		if len(repositories) != 3 || repositories[2].Name != "tf-unit-test-2" {
+			return fmt.Errorf("expected the repositories of both pages, got %s", body)
+		}
+		return nil
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: repositories,
+			},
+			{
+				Config: fmt.Sprintf(config, fakegithub.DefaultOrganization),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.github_rest_api.paginated", "code", "200"),
+					checkNames,
+					resource.TestCheckResourceAttr("data.github_rest_api.missing", "code", "404"),
+					resource.TestCheckResourceAttr("data.github_rest_api.missing", "headers.Content-Type", "application/json; charset=utf-8"),
+				),
+			},
+		},
+	})
+}
+
+func TestGithubRepositoryRulesetFake(t *testing.T) {
+	server := fakegithub.NewServer()
+	defer server.Close()
+
+	config := testFakeProviderConfig(server) + `
+		resource "github_repository" "test" {
+			name = "tf-unit-test"
+		}
+
+		resource "github_repository_ruleset" "test" {
+			repository  = github_repository.test.name
+			name        = "main"
+			target      = "branch"
+			enforcement = "%s"
+
+			bypass_actors {
+				actor_id    = 5
+				actor_type  = "RepositoryRole"
+				bypass_mode = "pull_request"
+			}
+
+			conditions {
+				ref_name {
+					include = ["~DEFAULT_BRANCH"]
+					exclude = []
+				}
+			}
+
+			rules {
+				deletion                = true
+				non_fast_forward        = true
+				required_linear_history = true
+
+				pull_request {
+					required_approving_review_count = 2
+					require_code_owner_review       = true
+				}
+
+				required_status_checks {
+					required_check {
+						context = "ci"
+					}
+					required_check {
+						context        = "lint"
+						integration_id = 42
+					}
+				}
+
+				commit_message_pattern {
+					operator = "starts_with"
+					pattern  = "JIRA-"
+				}
+			}
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, "evaluate"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository_ruleset.test", "enforcement", "evaluate"),
+					resource.TestCheckResourceAttr("github_repository_ruleset.test", "bypass_actors.0.bypass_mode", "pull_request"),
+					resource.TestCheckResourceAttr("github_repository_ruleset.test", "conditions.0.ref_name.0.include.0", "~DEFAULT_BRANCH"),
+					resource.TestCheckResourceAttr("github_repository_ruleset.test", "rules.0.deletion", "true"),
+					resource.TestCheckResourceAttr("github_repository_ruleset.test", "rules.0.creation", "false"),
+					resource.TestCheckResourceAttr("github_repository_ruleset.test", "rules.0.pull_request.0.required_approving_review_count", "2"),
+					resource.TestCheckResourceAttr("github_repository_ruleset.test", "rules.0.required_status_checks.0.required_check.#", "2"),
+					resource.TestCheckResourceAttr("github_repository_ruleset.test", "rules.0.commit_message_pattern.0.pattern", "JIRA-"),
+					resource.TestCheckResourceAttrSet("github_repository_ruleset.test", "ruleset_id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, "active"),
+				Check:  resource.TestCheckResourceAttr("github_repository_ruleset.test", "enforcement", "active"),
+			},
+			{
+				Config:            fmt.Sprintf(config, "active"),
+				ResourceName:      "github_repository_ruleset.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGithubOrganizationRulesetFake(t *testing.T) {
+	server := fakegithub.NewServer()
+	defer server.Close()
+
+	config := testFakeProviderConfig(server) + `
+		resource "github_team" "test" {
+			name = "tf-unit-team"
+		}
+
+		resource "github_organization_ruleset" "test" {
+			name        = "all-repositories"
+			target      = "branch"
+			enforcement = "evaluate"
+
+			bypass_actors {
+				actor_id   = github_team.test.id
+				actor_type = "Team"
+			}
+
+			bypass_actors {
+				actor_id   = 1
+				actor_type = "OrganizationAdmin"
+			}
+
+			conditions {
+				ref_name {
+					include = ["~DEFAULT_BRANCH"]
+					exclude = []
+				}
+				%s
+			}
+
+			rules {
+				deletion         = true
+				non_fast_forward = true
+
+				branch_name_pattern {
+					operator = "regex"
+					pattern  = "^(main|feature/.+)$"
+				}
+			}
+		}
+	`
+
+	byName := `
+		repository_name {
+			include = ["~ALL"]
+			exclude = ["tf-unit-excluded"]
+		}
+	`
+	byID := `repository_id = [1, 2]`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(config, byName+byID),
+				ExpectError: regexp.MustCompile("only one of"),
+			},
+			{
+				Config: fmt.Sprintf(config, byName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_organization_ruleset.test", "enforcement", "evaluate"),
+					resource.TestCheckResourceAttr("github_organization_ruleset.test", "bypass_actors.#", "2"),
+					resource.TestCheckResourceAttrPair("github_organization_ruleset.test", "bypass_actors.0.actor_id", "github_team.test", "id"),
+					resource.TestCheckResourceAttr("github_organization_ruleset.test", "conditions.0.repository_name.0.exclude.0", "tf-unit-excluded"),
+					resource.TestCheckResourceAttr("github_organization_ruleset.test", "rules.0.branch_name_pattern.0.operator", "regex"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, byID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_organization_ruleset.test", "conditions.0.repository_name.#", "0"),
+					resource.TestCheckResourceAttr("github_organization_ruleset.test", "conditions.0.repository_id.#", "2"),
+				),
+			},
+			{
+				Config:            fmt.Sprintf(config, byID),
+				ResourceName:      "github_organization_ruleset.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGithubRulesetsDataSourcesFake(t *testing.T) {
+	server := fakegithub.NewServer()
+	defer server.Close()
+
+	config := testFakeProviderConfig(server) + `
+		resource "github_repository" "test" {
+			name = "tf-unit-test"
+		}
+
+		resource "github_repository_ruleset" "test" {
+			repository  = github_repository.test.name
+			name        = "repository"
+			target      = "branch"
+			enforcement = "active"
+
+			rules {
+				deletion = true
+			}
+		}
+
+		resource "github_organization_ruleset" "test" {
+			name        = "organization"
+			target      = "tag"
+			enforcement = "evaluate"
+
+			conditions {
+				ref_name {
+					include = ["~ALL"]
+					exclude = []
+				}
+				repository_name {
+					include = ["~ALL"]
+					exclude = []
+				}
+			}
+
+			rules {
+				creation = true
+			}
+		}
+	`
+
+	dataSources := `
+		data "github_repository_rulesets" "all" {
+			repository = github_repository.test.name
+		}
+
+		data "github_repository_rulesets" "own" {
+			repository      = github_repository.test.name
+			include_parents = false
+		}
+
+		data "github_organization_rulesets" "test" {}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config: config + dataSources,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.github_repository_rulesets.all", "rulesets.#", "2"),
+					resource.TestCheckResourceAttr("data.github_repository_rulesets.all", "rulesets.0.source_type", "Organization"),
+					resource.TestCheckResourceAttr("data.github_repository_rulesets.all", "rulesets.0.target", "tag"),
+					resource.TestCheckResourceAttrPair("data.github_repository_rulesets.all", "rulesets.1.ruleset_id", "github_repository_ruleset.test", "ruleset_id"),
+					resource.TestCheckResourceAttr("data.github_repository_rulesets.all", "rulesets.1.source_type", "Repository"),
+					resource.TestCheckResourceAttr("data.github_repository_rulesets.all", "rulesets.1.enforcement", "active"),
+					resource.TestCheckResourceAttr("data.github_repository_rulesets.own", "rulesets.#", "1"),
+					resource.TestCheckResourceAttr("data.github_repository_rulesets.own", "rulesets.0.name", "repository"),
+					resource.TestCheckResourceAttr("data.github_organization_rulesets.test", "rulesets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.github_organization_rulesets.test", "rulesets.0.node_id", "github_organization_ruleset.test", "node_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestGithubCustomPropertiesFake(t *testing.T) {
+	server := fakegithub.NewServer()
+	defer server.Close()
+
+	config := testFakeProviderConfig(server) + `
+		resource "github_organization_custom_property" "tier" {
+			property_name  = "tier"
+			value_type     = "single_select"
+			allowed_values = ["gold", "silver"]
+			default_value  = ["silver"]
+		}
+
+		resource "github_organization_custom_property" "sensitivity" {
+			property_name  = "sensitivity"
+			value_type     = "multi_select"
+			allowed_values = ["pii", "pci"]
+			description    = "The kinds of sensitive data handled"
+		}
+
+		resource "github_repository" "test" {
+			count = 3
+			name  = "tf-unit-${count.index}"
+		}
+
+		resource "github_repository_custom_property" "tier" {
+			repository     = github_repository.test[0].name
+			property_name  = github_organization_custom_property.tier.property_name
+			property_type  = "single_select"
+			property_value = [%s]
+		}
+
+		resource "github_repository_custom_property" "sensitivity" {
+			repository     = github_repository.test[1].name
+			property_name  = github_organization_custom_property.sensitivity.property_name
+			property_type  = "multi_select"
+			property_value = ["pii", "pci"]
+		}
+	`
+
+	dataSources := `
+		data "github_repository" "test" {
+			count = 3
+			name  = github_repository.test[count.index].name
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(config, `"gold", "silver"`),
+				ExpectError: regexp.MustCompile("takes a single value"),
+			},
+			{
+				Config: fmt.Sprintf(config, `"gold"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_organization_custom_property.tier", "default_value.#", "1"),
+					resource.TestCheckResourceAttr("github_repository_custom_property.tier", "property_value.#", "1"),
+					resource.TestCheckResourceAttr("github_repository_custom_property.sensitivity", "property_value.#", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, `"silver"`) + dataSources,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.github_repository.test.0", "custom_properties.%", "1"),
+					resource.TestCheckResourceAttr("data.github_repository.test.1", "custom_properties.sensitivity", "pii,pci"),
+					resource.TestCheckResourceAttr("data.github_repository.test.2", "custom_properties.%", "0"),
+					func(*terraform.State) error {
+						// Repository 2 has no github_repository_custom_property, so only
+						// the data source reads its values, through the organization
+						if n := server.Requests("GET", "/repos/fake-org/tf-unit-2/properties/values"); n != 0 {
+							return fmt.Errorf("expected the values of tf-unit-2 to be listed with the organization, got %d requests for the repository", n)
+						}
+						if server.Requests("GET", "/orgs/fake-org/properties/values") == 0 {
+							return fmt.Errorf("expected the values of the organization to be listed")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, `"silver"`) + dataSources,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.github_repository.test.0", "custom_properties.tier", "silver"),
+				),
+			},
+			{
+				Config:            fmt.Sprintf(config, `"silver"`),
+				ResourceName:      "github_repository_custom_property.sensitivity",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:            fmt.Sprintf(config, `"silver"`),
+				ResourceName:      "github_organization_custom_property.sensitivity",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGithubProjectV2Fake(t *testing.T) {
+	server := fakegithub.NewServer()
+	defer server.Close()
+
+	config := testFakeProviderConfig(server) + `
+		resource "github_project_v2" "test" {
+			title             = "tf-unit-project"
+			short_description = "%s"
+			public            = true
+		}
+
+		resource "github_project_v2_field" "notes" {
+			project_id = github_project_v2.test.id
+			name       = "Notes"
+			data_type  = "TEXT"
+		}
+
+		resource "github_project_v2_field" "estimate" {
+			project_id = github_project_v2.test.id
+			name       = "Estimate"
+			data_type  = "NUMBER"
+		}
+
+		resource "github_project_v2_field" "due" {
+			project_id = github_project_v2.test.id
+			name       = "Due"
+			data_type  = "DATE"
+		}
+
+		resource "github_project_v2_field" "priority" {
+			project_id = github_project_v2.test.id
+			name       = "Priority"
+			data_type  = "SINGLE_SELECT"
+
+			option {
+				name  = "High"
+				color = "RED"
+			}
+
+			option {
+				name = "Low"
+			}
+		}
+
+		resource "github_project_v2_field" "sprint" {
+			project_id = github_project_v2.test.id
+			name       = "Sprint"
+			data_type  = "ITERATION"
+
+			iteration_configuration {
+				start_date = "2999-01-04"
+				duration   = 14
+			}
+		}
+
+		resource "github_project_v2_item" "test" {
+			project_id = github_project_v2.test.id
+
+			draft_issue {
+				title = "%s"
+				body  = "Drafted by Terraform"
+			}
+
+			%s
+		}
+	`
+
+	fieldValues := `
+		field_value {
+			field_id = github_project_v2_field.notes.id
+			text     = "Some notes"
+		}
+
+		field_value {
+			field_id = github_project_v2_field.estimate.id
+			number   = 3
+		}
+
+		field_value {
+			field_id = github_project_v2_field.due.id
+			date     = "2999-01-31"
+		}
+
+		field_value {
+			field_id                = github_project_v2_field.priority.id
+			single_select_option_id = github_project_v2_field.priority.option_ids["High"]
+		}
+
+		field_value {
+			field_id     = github_project_v2_field.sprint.id
+			iteration_id = github_project_v2_field.sprint.iterations[0].id
+		}
+	`
+
+	updatedFieldValues := `
+		field_value {
+			field_id                = github_project_v2_field.priority.id
+			single_select_option_id = github_project_v2_field.priority.option_ids["Low"]
+		}
+	`
+
+	dataSource := `
+		data "github_project_v2" "test" {
+			number = github_project_v2.test.number
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig(server) + `
+					resource "github_project_v2_field" "test" {
+						project_id = "PVT_0"
+						name       = "Priority"
+						data_type  = "SINGLE_SELECT"
+					}
+				`,
+				ExpectError: regexp.MustCompile("needs at least one option"),
+			},
+			{
+				Config: fmt.Sprintf(config, "Created", "Draft", fieldValues),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_project_v2.test", "number", "1"),
+					resource.TestCheckResourceAttr("github_project_v2.test", "public", "true"),
+					resource.TestCheckResourceAttr("github_project_v2_field.priority", "option_ids.%", "2"),
+					resource.TestCheckResourceAttr("github_project_v2_field.sprint", "iterations.#", "3"),
+					resource.TestCheckResourceAttr("github_project_v2_field.sprint", "iterations.1.start_date", "2999-01-18"),
+					resource.TestCheckResourceAttr("github_project_v2_item.test", "type", "DRAFT_ISSUE"),
+					// The status the workflow set is not drift, so the plan is
+					// empty after the apply
+					resource.TestCheckResourceAttr("github_project_v2_item.test", "field_value.#", "5"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, "Updated", "Renamed draft", updatedFieldValues),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_project_v2.test", "short_description", "Updated"),
+					resource.TestCheckResourceAttr("github_project_v2_item.test", "draft_issue.0.title", "Renamed draft"),
+					resource.TestCheckResourceAttr("github_project_v2_item.test", "field_value.#", "1"),
+				),
+			},
+			{
+				Config:            fmt.Sprintf(config, "Updated", "Renamed draft", updatedFieldValues),
+				ResourceName:      "github_project_v2.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:            fmt.Sprintf(config, "Updated", "Renamed draft", updatedFieldValues),
+				ResourceName:      "github_project_v2_field.sprint",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:            fmt.Sprintf(config, "Updated", "Renamed draft", updatedFieldValues),
+				ResourceName:      "github_project_v2_item.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// An import takes the values of every field, including the
+				// status the workflow set
+				ImportStateVerifyIgnore: []string{"field_value"},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if n := states[0].Attributes["field_value.#"]; n != "2" {
+						return fmt.Errorf("expected the values of 2 fields to be imported, got %s", n)
+					}
+					return nil
+				},
+			},
+			{
+				Config: fmt.Sprintf(config, "Updated", "Renamed draft", updatedFieldValues) + dataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.github_project_v2.test", "id", "github_project_v2.test", "id"),
+					resource.TestCheckResourceAttr("data.github_project_v2.test", "title", "tf-unit-project"),
+					// The built-in Title and Status fields, and the five above
+					resource.TestCheckResourceAttr("data.github_project_v2.test", "fields.#", "7"),
+					resource.TestCheckResourceAttr("data.github_project_v2.test", "fields.1.name", "Status"),
+					resource.TestCheckResourceAttr("data.github_project_v2.test", "fields.1.options.#", "3"),
+					resource.TestCheckResourceAttr("data.github_project_v2.test", "views.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -2,9 +2,16 @@ package github
 
 import (
 	"fmt"
+	"hash/fnv"
 	"log"
+	"math/rand"
 	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 var testCollaborator = os.Getenv("GITHUB_TEST_COLLABORATOR")
@@ -16,11 +23,15 @@ var testToken = os.Getenv("GITHUB_TOKEN")
 var testBaseURLGHES = os.Getenv("GHES_BASE_URL")
 
 func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("GITHUB_TOKEN"); v == "" {
-		t.Fatal("GITHUB_TOKEN must be set for acceptance tests")
-	}
-	if v := os.Getenv("GITHUB_ORGANIZATION"); v == "" && os.Getenv("GITHUB_OWNER") == "" {
-		t.Fatal("GITHUB_ORGANIZATION or GITHUB_OWNER must be set for acceptance tests")
+	// Replayed tests send no request to GitHub, and take their owner from
+	// the cassette
+	if os.Getenv(recorderModeEnv) != recorderModeReplay {
+		if v := os.Getenv("GITHUB_TOKEN"); v == "" {
+			t.Fatal("GITHUB_TOKEN must be set for acceptance tests")
+		}
+		if v := os.Getenv("GITHUB_ORGANIZATION"); v == "" && os.Getenv("GITHUB_OWNER") == "" {
+			t.Fatal("GITHUB_ORGANIZATION or GITHUB_OWNER must be set for acceptance tests")
+		}
 	}
 	if v := os.Getenv("GITHUB_TEST_USER"); v == "" {
 		t.Fatal("GITHUB_TEST_USER must be set for acceptance tests")
@@ -74,6 +85,70 @@ func skipUnlessMode(t *testing.T, providerMode string) {
 	t.Skipf("Skipping %s which requires %s mode", t.Name(), providerMode)
 }
 
+// testAccUseCassette records the API interactions of the calling test to, or
+// replays them from, a cassette named after the test when GITHUB_RECORDER_MODE
+// is set. It returns the source of the random names of the test, which is
+// seeded from the test name in that case, so that a replayed test sends the
+// same requests as the recorded one.
+//
+// The owner and base URL a cassette was recorded with are stored in it and
+// set again when it is replayed, along with a placeholder token, so that a
+// replayed test needs no credentials and runs in the same modes.
+func testAccUseCassette(t *testing.T) *rand.Rand {
+	mode := os.Getenv(recorderModeEnv)
+	if mode == "" {
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	path := filepath.Join("test-fixtures", "cassettes", filepath.FromSlash(t.Name())+".json")
+	switch mode {
+	case recorderModeRecord:
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			t.Fatalf("unable to remove cassette %s: %s", path, err)
+		}
+		cassettesMutex.Lock()
+		delete(cassettes, path)
+		cassettesMutex.Unlock()
+
+		c, err := loadCassette(path, mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		environment := make(map[string]string)
+		for _, name := range recorderEnvironment {
+			if v := os.Getenv(name); v != "" {
+				environment[name] = v
+			}
+		}
+		if err := c.setEnvironment(environment); err != nil {
+			t.Fatalf("unable to write cassette %s: %s", path, err)
+		}
+	case recorderModeReplay:
+		c, err := loadCassette(path, mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range recorderEnvironment {
+			t.Setenv(name, c.Environment[name])
+		}
+		t.Setenv("GITHUB_TOKEN", redactedValue)
+	}
+	t.Setenv(recorderCassetteEnv, path)
+
+	h := fnv.New64a()
+	h.Write([]byte(t.Name()))
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+// testAccRandString is acctest.RandStringFromCharSet drawing from random
+func testAccRandString(random *rand.Rand, length int, charSet string) string {
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = charSet[random.Intn(len(charSet))]
+	}
+	return string(result)
+}
+
 func testAccCheckOrganization() error {
 
 	baseURL := os.Getenv("GITHUB_BASE_URL")
@@ -114,6 +189,21 @@ func OwnerOrOrgEnvDefaultFunc() (interface{}, error) {
 	return owner, nil
 }
 
+// boolEnvDefaultFunc is schema.EnvDefaultFunc for boolean arguments, parsing
+// the value of the environment variable when it is set.
+func boolEnvDefaultFunc(k string, dv bool) schema.SchemaDefaultFunc {
+	return func() (interface{}, error) {
+		if v := os.Getenv(k); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be a boolean: %s", k, err)
+			}
+			return b, nil
+		}
+		return dv, nil
+	}
+}
+
 func testOrganizationFunc() string {
 	organization := os.Getenv("GITHUB_ORGANIZATION")
 	if organization == "" {
@@ -10,8 +10,8 @@ import (
 )
 
 // checkRepositoryBranchExists tests if a branch exists in a repository.
-func checkRepositoryBranchExists(client *github.Client, owner, repo, branch string) error {
-	ctx := context.WithValue(context.Background(), ctxId, buildTwoPartID(repo, branch))
+func checkRepositoryBranchExists(ctx context.Context, client *github.Client, owner, repo, branch string) error {
+	ctx = context.WithValue(ctx, ctxId, buildTwoPartID(repo, branch))
 	_, _, err := client.Repositories.GetBranch(ctx, owner, repo, branch, true)
 	if err != nil {
 		if ghErr, ok := err.(*github.ErrorResponse); ok {
@@ -25,8 +25,8 @@ func checkRepositoryBranchExists(client *github.Client, owner, repo, branch stri
 	return nil
 }
 
-func getFileCommit(client *github.Client, owner, repo, file, branch string) (*github.RepositoryCommit, error) {
-	ctx := context.WithValue(context.Background(), ctxId, fmt.Sprintf("%s/%s", repo, file))
+func getFileCommit(ctx context.Context, client *github.Client, owner, repo, file, branch string) (*github.RepositoryCommit, error) {
+	ctx = context.WithValue(ctx, ctxId, fmt.Sprintf("%s/%s", repo, file))
 	opts := &github.CommitsListOptions{
 		SHA:  branch,
 		Path: file,
@@ -71,8 +71,8 @@ func getFileCommit(client *github.Client, owner, repo, file, branch string) (*gi
 }
 
 // getAutolinkByKeyPrefix returns a single autolink reference by key prefix that was configured for the given repository.
-func getAutolinkByKeyPrefix(client *github.Client, owner, repo, keyPrefix string) (*github.Autolink, error) {
-	autolinks, err := listAutolinks(client, owner, repo)
+func getAutolinkByKeyPrefix(ctx context.Context, client *github.Client, owner, repo, keyPrefix string) (*github.Autolink, error) {
+	autolinks, err := listAutolinks(ctx, client, owner, repo)
 	if err != nil {
 		return nil, err
 	}
@@ -87,8 +87,8 @@ func getAutolinkByKeyPrefix(client *github.Client, owner, repo, keyPrefix string
 }
 
 // listAutolinks returns all autolink references for the given repository.
-func listAutolinks(client *github.Client, owner, repo string) ([]*github.Autolink, error) {
-	ctx := context.WithValue(context.Background(), ctxId, fmt.Sprintf("%s/%s", owner, repo))
+func listAutolinks(ctx context.Context, client *github.Client, owner, repo string) ([]*github.Autolink, error) {
+	ctx = context.WithValue(ctx, ctxId, fmt.Sprintf("%s/%s", owner, repo))
 	opts := &github.ListOptions{
 		PerPage: maxPerPage,
 	}
@@ -69,9 +69,11 @@ func resourceGithubActionsEnvironmentSecret() *schema.Resource {
 }
 
 func resourceGithubActionsEnvironmentSecretCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repoName := d.Get("repository").(string)
 	envName := d.Get("environment").(string)
@@ -84,7 +86,7 @@ func resourceGithubActionsEnvironmentSecretCreateOrUpdate(d *schema.ResourceData
 		return err
 	}
 
-	keyId, publicKey, err := getEnvironmentPublicKeyDetails(repo.GetID(), envName, meta)
+	keyId, publicKey, err := getEnvironmentPublicKeyDetails(ctx, repo.GetID(), envName, meta)
 	if err != nil {
 		return err
 	}
@@ -116,9 +118,11 @@ func resourceGithubActionsEnvironmentSecretCreateOrUpdate(d *schema.ResourceData
 }
 
 func resourceGithubActionsEnvironmentSecretRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repoName, envName, secretName, err := parseThreePartID(d.Id(), "repository", "environment", "secret_name")
 	if err != nil {
@@ -173,9 +177,12 @@ func resourceGithubActionsEnvironmentSecretRead(d *schema.ResourceData, meta int
 }
 
 func resourceGithubActionsEnvironmentSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	repoName, envName, secretName, err := parseThreePartID(d.Id(), "repository", "environment", "secret_name")
 	if err != nil {
@@ -191,10 +198,8 @@ func resourceGithubActionsEnvironmentSecretDelete(d *schema.ResourceData, meta i
 	return err
 }
 
-func getEnvironmentPublicKeyDetails(repoID int64, envName string, meta interface{}) (keyId, pkValue string, err error) {
+func getEnvironmentPublicKeyDetails(ctx context.Context, repoID int64, envName string, meta interface{}) (keyId, pkValue string, err error) {
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
-
 	publicKey, _, err := client.Actions.GetEnvPublicKey(ctx, int(repoID), envName)
 	if err != nil {
 		return keyId, pkValue, err
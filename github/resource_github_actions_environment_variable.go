@@ -58,9 +58,11 @@ func resourceGithubActionsEnvironmentVariable() *schema.Resource {
 }
 
 func resourceGithubActionsEnvironmentVariableCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repoName := d.Get("repository").(string)
 	env := d.Get("environment").(string)
@@ -86,9 +88,11 @@ func resourceGithubActionsEnvironmentVariableCreate(d *schema.ResourceData, meta
 }
 
 func resourceGithubActionsEnvironmentVariableUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repoName := d.Get("repository").(string)
 	env := d.Get("environment").(string)
@@ -113,9 +117,11 @@ func resourceGithubActionsEnvironmentVariableUpdate(d *schema.ResourceData, meta
 }
 
 func resourceGithubActionsEnvironmentVariableRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repoName, env, name, err := parseThreePartID(d.Id(), "repository", "environment", "variable_name")
 	if err != nil {
@@ -151,9 +157,12 @@ func resourceGithubActionsEnvironmentVariableRead(d *schema.ResourceData, meta i
 }
 
 func resourceGithubActionsEnvironmentVariableDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	repoName, env, name, err := parseThreePartID(d.Id(), "repository", "environment", "variable_name")
 	if err != nil {
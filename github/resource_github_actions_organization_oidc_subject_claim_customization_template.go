@@ -1,8 +1,6 @@
 package github
 
 import (
-	"context"
-
 	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -31,9 +29,11 @@ func resourceGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplate() *s
 }
 
 func resourceGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplateCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.Background()
 
 	err := checkOrganization(meta)
 	if err != nil {
@@ -61,8 +61,10 @@ func resourceGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplateCreat
 }
 
 func resourceGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 	orgName := meta.(*Owner).name
 
 	err := checkOrganization(meta)
@@ -82,12 +84,13 @@ func resourceGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplateRead(
 }
 
 func resourceGithubActionsOrganizationOIDCSubjectClaimCustomizationTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
 
 	// Sets include_claim_keys back to GitHub's defaults
 	// https://docs.github.com/en/actions/deployment/security-hardening-your-deployments/about-security-hardening-with-openid-connect#resetting-your-customizations
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.Background()
 
 	err := checkOrganization(meta)
 	if err != nil {
@@ -132,9 +132,11 @@ func resourceGithubActionsEnabledRepositoriesObject(d *schema.ResourceData) ([]i
 }
 
 func resourceGithubActionsOrganizationPermissionsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.Background()
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxId, d.Id())
 	}
@@ -188,8 +190,10 @@ func resourceGithubActionsOrganizationPermissionsCreateOrUpdate(d *schema.Resour
 }
 
 func resourceGithubActionsOrganizationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	err := checkOrganization(meta)
 	if err != nil {
@@ -260,9 +264,12 @@ func resourceGithubActionsOrganizationPermissionsRead(d *schema.ResourceData, me
 }
 
 func resourceGithubActionsOrganizationPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	err := checkOrganization(meta)
 	if err != nil {
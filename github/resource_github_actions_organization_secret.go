@@ -81,9 +81,11 @@ func resourceGithubActionsOrganizationSecret() *schema.Resource {
 }
 
 func resourceGithubActionsOrganizationSecretCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	secretName := d.Get("secret_name").(string)
 	plaintextValue := d.Get("plaintext_value").(string)
@@ -106,7 +108,7 @@ func resourceGithubActionsOrganizationSecretCreateOrUpdate(d *schema.ResourceDat
 		}
 	}
 
-	keyId, publicKey, err := getOrganizationPublicKeyDetails(owner, meta)
+	keyId, publicKey, err := getOrganizationPublicKeyDetails(ctx, owner, meta)
 	if err != nil {
 		return err
 	}
@@ -140,9 +142,11 @@ func resourceGithubActionsOrganizationSecretCreateOrUpdate(d *schema.ResourceDat
 }
 
 func resourceGithubActionsOrganizationSecretRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	secret, _, err := client.Actions.GetOrgSecret(ctx, owner, d.Id())
 	if err != nil {
@@ -213,18 +217,20 @@ func resourceGithubActionsOrganizationSecretRead(d *schema.ResourceData, meta in
 }
 
 func resourceGithubActionsOrganizationSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	log.Printf("[INFO] Deleting secret: %s", d.Id())
 	_, err := client.Actions.DeleteOrgSecret(ctx, orgName, d.Id())
 	return err
 }
 
-func getOrganizationPublicKeyDetails(owner string, meta interface{}) (keyId, pkValue string, err error) {
+func getOrganizationPublicKeyDetails(ctx context.Context, owner string, meta interface{}) (keyId, pkValue string, err error) {
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	publicKey, _, err := client.Actions.GetOrgPublicKey(ctx, owner)
 	if err != nil {
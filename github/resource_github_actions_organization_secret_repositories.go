@@ -39,9 +39,11 @@ func resourceGithubActionsOrganizationSecretRepositories() *schema.Resource {
 }
 
 func resourceGithubActionsOrganizationSecretRepositoriesCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	err := checkOrganization(meta)
 	if err != nil {
@@ -68,9 +70,11 @@ func resourceGithubActionsOrganizationSecretRepositoriesCreateOrUpdate(d *schema
 }
 
 func resourceGithubActionsOrganizationSecretRepositoriesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	err := checkOrganization(meta)
 	if err != nil {
@@ -103,9 +107,12 @@ func resourceGithubActionsOrganizationSecretRepositoriesRead(d *schema.ResourceD
 }
 
 func resourceGithubActionsOrganizationSecretRepositoriesDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	err := checkOrganization(meta)
 	if err != nil {
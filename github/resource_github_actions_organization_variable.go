@@ -64,9 +64,11 @@ func resourceGithubActionsOrganizationVariable() *schema.Resource {
 }
 
 func resourceGithubActionsOrganizationVariableCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	name := d.Get("variable_name").(string)
 
@@ -105,9 +107,11 @@ func resourceGithubActionsOrganizationVariableCreate(d *schema.ResourceData, met
 }
 
 func resourceGithubActionsOrganizationVariableUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	name := d.Get("variable_name").(string)
 
@@ -147,9 +151,11 @@ func resourceGithubActionsOrganizationVariableUpdate(d *schema.ResourceData, met
 }
 
 func resourceGithubActionsOrganizationVariableRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	name := d.Id()
 
@@ -201,9 +207,12 @@ func resourceGithubActionsOrganizationVariableRead(d *schema.ResourceData, meta
 }
 
 func resourceGithubActionsOrganizationVariableDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	name := d.Id()
 
@@ -36,10 +36,12 @@ func resourceGithubActionsRepositoryAccessLevel() *schema.Resource {
 }
 
 func resourceGithubActionsRepositoryAccessLevelCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
-	ctx := context.Background()
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxId, d.Id())
 	}
@@ -59,10 +61,13 @@ func resourceGithubActionsRepositoryAccessLevelCreateOrUpdate(d *schema.Resource
 }
 
 func resourceGithubActionsRepositoryAccessLevelRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	repoName := d.Id()
-	ctx := context.WithValue(context.Background(), ctxId, repoName)
+	ctx = context.WithValue(ctx, ctxId, repoName)
 
 	actionAccessLevel, _, err := client.Repositories.GetActionsAccessLevel(ctx, owner, repoName)
 	if err != nil {
@@ -75,10 +80,13 @@ func resourceGithubActionsRepositoryAccessLevelRead(d *schema.ResourceData, meta
 }
 
 func resourceGithubActionsRepositoryAccessLevelDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	repoName := d.Id()
-	ctx := context.WithValue(context.Background(), ctxId, repoName)
+	ctx = context.WithValue(ctx, ctxId, repoName)
 
 	actionAccessLevel := github.RepositoryActionsAccessLevel{
 		AccessLevel: github.String("none"),
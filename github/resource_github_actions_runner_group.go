@@ -91,6 +91,9 @@ func resourceGithubActionsRunnerGroup() *schema.Resource {
 }
 
 func resourceGithubActionsRunnerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -125,8 +128,6 @@ func resourceGithubActionsRunnerGroupCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	ctx := context.Background()
-
 	runnerGroup, resp, err := client.Actions.CreateOrganizationRunnerGroup(ctx,
 		orgName,
 		github.CreateRunnerGroupRequest{
@@ -170,6 +171,9 @@ func getOrganizationRunnerGroup(client *github.Client, ctx context.Context, org
 }
 
 func resourceGithubActionsRunnerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -182,7 +186,7 @@ func resourceGithubActionsRunnerGroupRead(d *schema.ResourceData, meta interface
 	if err != nil {
 		return err
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -245,6 +249,9 @@ func resourceGithubActionsRunnerGroupRead(d *schema.ResourceData, meta interface
 }
 
 func resourceGithubActionsRunnerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -276,7 +283,7 @@ func resourceGithubActionsRunnerGroupUpdate(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	if _, _, err := client.Actions.UpdateOrganizationRunnerGroup(ctx, orgName, runnerGroupID, options); err != nil {
 		return err
@@ -303,6 +310,9 @@ func resourceGithubActionsRunnerGroupUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceGithubActionsRunnerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -314,7 +324,7 @@ func resourceGithubActionsRunnerGroupDelete(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	log.Printf("[INFO] Deleting organization runner group: %s (%s)", d.Id(), orgName)
 	_, err = client.Actions.DeleteOrganizationRunnerGroup(ctx, orgName, runnerGroupID)
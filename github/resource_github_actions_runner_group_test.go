@@ -1,10 +1,13 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"strings"
 	"testing"
 
+	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -343,3 +346,47 @@ func TestAccGithubActionsRunnerGroup(t *testing.T) {
 		})
 	})
 }
+
+func testSweepRunnerGroups(region string) error {
+	owner, err := testSweepOrganization(region)
+	if err != nil {
+		return err
+	}
+	client := owner.v3client
+	ctx := context.Background()
+
+	var errs []string
+	opts := &github.ListOrgRunnerGroupOptions{ListOptions: github.ListOptions{PerPage: maxPerPage}}
+	for {
+		groups, resp, err := client.Actions.ListOrganizationRunnerGroups(ctx, owner.name, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, group := range groups.RunnerGroups {
+			name, id := group.GetName(), group.GetID()
+			if !strings.HasPrefix(name, testSweepPrefix) {
+				continue
+			}
+			if err := testSweep("runner group", name, func() (*github.Response, error) {
+				return client.Actions.DeleteOrganizationRunnerGroup(ctx, owner.name, id)
+			}); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return testSweepErrors(errs)
+}
+
+func init() {
+	resource.AddTestSweepers("github_actions_runner_group", &resource.Sweeper{
+		Name: "github_actions_runner_group",
+		F:    testSweepRunnerGroups,
+	})
+}
@@ -67,16 +67,18 @@ func resourceGithubActionsSecret() *schema.Resource {
 }
 
 func resourceGithubActionsSecretCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repo := d.Get("repository").(string)
 	secretName := d.Get("secret_name").(string)
 	plaintextValue := d.Get("plaintext_value").(string)
 	var encryptedValue string
 
-	keyId, publicKey, err := getPublicKeyDetails(owner, repo, meta)
+	keyId, publicKey, err := getPublicKeyDetails(ctx, owner, repo, meta)
 	if err != nil {
 		return err
 	}
@@ -108,9 +110,11 @@ func resourceGithubActionsSecretCreateOrUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceGithubActionsSecretRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repoName, secretName, err := parseTwoPartID(d.Id(), "repository", "secret_name")
 	if err != nil {
@@ -160,9 +164,12 @@ func resourceGithubActionsSecretRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGithubActionsSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	repoName, secretName, err := parseTwoPartID(d.Id(), "repository", "secret_name")
 	if err != nil {
@@ -175,9 +182,10 @@ func resourceGithubActionsSecretDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubActionsSecretImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 {
@@ -207,10 +215,8 @@ func resourceGithubActionsSecretImport(d *schema.ResourceData, meta interface{})
 	return []*schema.ResourceData{d}, nil
 }
 
-func getPublicKeyDetails(owner, repository string, meta interface{}) (keyId, pkValue string, err error) {
+func getPublicKeyDetails(ctx context.Context, owner, repository string, meta interface{}) (keyId, pkValue string, err error) {
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
-
 	publicKey, _, err := client.Actions.GetRepoPublicKey(ctx, owner, repository)
 	if err != nil {
 		return keyId, pkValue, err
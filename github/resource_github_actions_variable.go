@@ -52,9 +52,11 @@ func resourceGithubActionsVariable() *schema.Resource {
 }
 
 func resourceGithubActionsVariableCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repo := d.Get("repository").(string)
 	variable := &github.ActionsVariable{
@@ -72,9 +74,11 @@ func resourceGithubActionsVariableCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGithubActionsVariableUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repo := d.Get("repository").(string)
 	variable := &github.ActionsVariable{
@@ -92,9 +96,11 @@ func resourceGithubActionsVariableUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGithubActionsVariableRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repoName, variableName, err := parseTwoPartID(d.Id(), "repository", "variable_name")
 	if err != nil {
@@ -124,9 +130,12 @@ func resourceGithubActionsVariableRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubActionsVariableDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	repoName, variableName, err := parseTwoPartID(d.Id(), "repository", "variable_name")
 	if err != nil {
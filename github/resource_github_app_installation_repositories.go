@@ -40,12 +40,15 @@ func resourceGithubAppInstallationRepositories() *schema.Resource {
 }
 
 func resourceGithubAppInstallationRepositoriesCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	installationIDString := d.Get("installation_id").(string)
 	selectedRepositories := d.Get("selected_repositories")
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, installationIDString)
+	ctx = context.WithValue(ctx, ctxId, installationIDString)
 
 	selectedRepositoryNames := []string{}
 
@@ -54,7 +57,7 @@ func resourceGithubAppInstallationRepositoriesCreateOrUpdate(d *schema.ResourceD
 		selectedRepositoryNames = append(selectedRepositoryNames, name.(string))
 	}
 
-	currentReposNameIDs, instID, err := getAllAccessibleRepos(meta, installationIDString)
+	currentReposNameIDs, instID, err := getAllAccessibleRepos(ctx, meta, installationIDString)
 	if err != nil {
 		return err
 	}
@@ -97,9 +100,12 @@ func resourceGithubAppInstallationRepositoriesCreateOrUpdate(d *schema.ResourceD
 }
 
 func resourceGithubAppInstallationRepositoriesRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	installationIDString := d.Id()
 
-	reposNameIDs, _, err := getAllAccessibleRepos(meta, installationIDString)
+	reposNameIDs, _, err := getAllAccessibleRepos(ctx, meta, installationIDString)
 	if err != nil {
 		return err
 	}
@@ -122,15 +128,18 @@ func resourceGithubAppInstallationRepositoriesRead(d *schema.ResourceData, meta
 }
 
 func resourceGithubAppInstallationRepositoriesDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	installationIDString := d.Get("installation_id").(string)
 
-	reposNameIDs, instID, err := getAllAccessibleRepos(meta, installationIDString)
+	reposNameIDs, instID, err := getAllAccessibleRepos(ctx, meta, installationIDString)
 	if err != nil {
 		return err
 	}
 
 	client := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, installationIDString)
+	ctx = context.WithValue(ctx, ctxId, installationIDString)
 
 	// There is a github limitation that means we can't remove the last repository from an installation.
 	// Therefore, we skip the first and delete the rest. The app will then need to be uninstalled via the GUI
@@ -152,7 +161,7 @@ func resourceGithubAppInstallationRepositoriesDelete(d *schema.ResourceData, met
 	return nil
 }
 
-func getAllAccessibleRepos(meta interface{}, idString string) (map[string]int64, int64, error) {
+func getAllAccessibleRepos(ctx context.Context, meta interface{}, idString string) (map[string]int64, int64, error) {
 	err := checkOrganization(meta)
 	if err != nil {
 		return nil, 0, err
@@ -163,7 +172,7 @@ func getAllAccessibleRepos(meta interface{}, idString string) (map[string]int64,
 		return nil, 0, unconvertibleIdErr(idString, err)
 	}
 
-	ctx := context.WithValue(context.Background(), ctxId, idString)
+	ctx = context.WithValue(ctx, ctxId, idString)
 	opt := &github.ListOptions{PerPage: maxPerPage}
 	client := meta.(*Owner).v3client
 
@@ -40,6 +40,9 @@ func resourceGithubAppInstallationRepository() *schema.Resource {
 }
 
 func resourceGithubAppInstallationRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -53,7 +56,6 @@ func resourceGithubAppInstallationRepositoryCreate(d *schema.ResourceData, meta
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 	repoName := d.Get("repository").(string)
 	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
 	if err != nil {
@@ -71,6 +73,9 @@ func resourceGithubAppInstallationRepositoryCreate(d *schema.ResourceData, meta
 }
 
 func resourceGithubAppInstallationRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -87,7 +92,7 @@ func resourceGithubAppInstallationRepositoryRead(d *schema.ResourceData, meta in
 		return unconvertibleIdErr(installationIDString, err)
 	}
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	opt := &github.ListOptions{PerPage: maxPerPage}
 
 	for {
@@ -118,6 +123,9 @@ func resourceGithubAppInstallationRepositoryRead(d *schema.ResourceData, meta in
 }
 
 func resourceGithubAppInstallationRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -130,7 +138,6 @@ func resourceGithubAppInstallationRepositoryDelete(d *schema.ResourceData, meta
 	}
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	repoID := d.Get("repo_id").(int)
 
@@ -67,7 +67,9 @@ func resourceGithubBranch() *schema.Resource {
 }
 
 func resourceGithubBranchCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.Background()
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxId, d.Id())
 	}
@@ -107,7 +109,9 @@ func resourceGithubBranchCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceGithubBranchRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -148,7 +152,9 @@ func resourceGithubBranchRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGithubBranchDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
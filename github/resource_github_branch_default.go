@@ -40,6 +40,8 @@ func resourceGithubBranchDefault() *schema.Resource {
 }
 
 func resourceGithubBranchDefaultCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
@@ -47,8 +49,6 @@ func resourceGithubBranchDefaultCreate(d *schema.ResourceData, meta interface{})
 	defaultBranch := d.Get("branch").(string)
 	rename := d.Get("rename").(bool)
 
-	ctx := context.Background()
-
 	if rename {
 		repository, _, err := client.Repositories.Get(ctx, owner, repoName)
 		if err != nil {
@@ -73,12 +73,14 @@ func resourceGithubBranchDefaultCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubBranchDefaultRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	repoName := d.Id()
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	repository, _, err := client.Repositories.Get(ctx, owner, repoName)
 	if err != nil {
@@ -96,6 +98,8 @@ func resourceGithubBranchDefaultRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGithubBranchDefaultDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
@@ -105,13 +109,13 @@ func resourceGithubBranchDefaultDelete(d *schema.ResourceData, meta interface{})
 		DefaultBranch: nil,
 	}
 
-	ctx := context.Background()
-
 	_, _, err := client.Repositories.Edit(ctx, owner, repoName, repository)
 	return err
 }
 
 func resourceGithubBranchDefaultUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
@@ -119,8 +123,6 @@ func resourceGithubBranchDefaultUpdate(d *schema.ResourceData, meta interface{})
 	defaultBranch := d.Get("branch").(string)
 	rename := d.Get("rename").(bool)
 
-	ctx := context.Background()
-
 	if rename {
 		repository, _, err := client.Repositories.Get(ctx, owner, repoName)
 		if err != nil {
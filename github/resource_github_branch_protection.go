@@ -179,6 +179,9 @@ func resourceGithubBranchProtection() *schema.Resource {
 }
 
 func resourceGithubBranchProtectionCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	var mutate struct {
 		CreateBranchProtectionRule struct {
 			BranchProtectionRule struct {
@@ -186,28 +189,28 @@ func resourceGithubBranchProtectionCreate(d *schema.ResourceData, meta interface
 			}
 		} `graphql:"createBranchProtectionRule(input: $input)"`
 	}
-	data, err := branchProtectionResourceData(d, meta)
+	data, err := branchProtectionResourceData(ctx, d, meta)
 	if err != nil {
 		return err
 	}
 
 	var reviewIds, pushIds, bypassForcePushIds, bypassPullRequestIds []string
-	reviewIds, err = getActorIds(data.ReviewDismissalActorIDs, meta)
+	reviewIds, err = getActorIds(ctx, data.ReviewDismissalActorIDs, meta)
 	if err != nil {
 		return err
 	}
 
-	pushIds, err = getActorIds(data.PushActorIDs, meta)
+	pushIds, err = getActorIds(ctx, data.PushActorIDs, meta)
 	if err != nil {
 		return err
 	}
 
-	bypassForcePushIds, err = getActorIds(data.BypassForcePushActorIDs, meta)
+	bypassForcePushIds, err = getActorIds(ctx, data.BypassForcePushActorIDs, meta)
 	if err != nil {
 		return err
 	}
 
-	bypassPullRequestIds, err = getActorIds(data.BypassPullRequestActorIDs, meta)
+	bypassPullRequestIds, err = getActorIds(ctx, data.BypassPullRequestActorIDs, meta)
 	if err != nil {
 		return err
 	}
@@ -244,7 +247,6 @@ func resourceGithubBranchProtectionCreate(d *schema.ResourceData, meta interface
 		RequireLastPushApproval:        githubv4.NewBoolean(githubv4.Boolean(data.RequireLastPushApproval)),
 	}
 
-	ctx := context.Background()
 	client := meta.(*Owner).v4client
 	err = client.Mutate(ctx, &mutate, input, nil)
 	if err != nil {
@@ -257,6 +259,9 @@ func resourceGithubBranchProtectionCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceGithubBranchProtectionRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	var query struct {
 		Node struct {
 			Node BranchProtectionRule `graphql:"... on BranchProtectionRule"`
@@ -266,7 +271,7 @@ func resourceGithubBranchProtectionRead(d *schema.ResourceData, meta interface{}
 		"id": d.Id(),
 	}
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	client := meta.(*Owner).v4client
 	err := client.Query(ctx, &query, variables)
 	if err != nil {
@@ -364,6 +369,9 @@ func resourceGithubBranchProtectionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGithubBranchProtectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	var mutate struct {
 		UpdateBranchProtectionRule struct {
 			BranchProtectionRule struct {
@@ -371,28 +379,28 @@ func resourceGithubBranchProtectionUpdate(d *schema.ResourceData, meta interface
 			}
 		} `graphql:"updateBranchProtectionRule(input: $input)"`
 	}
-	data, err := branchProtectionResourceData(d, meta)
+	data, err := branchProtectionResourceData(ctx, d, meta)
 	if err != nil {
 		return err
 	}
 
 	var reviewIds, pushIds, bypassForcePushIds, bypassPullRequestIds []string
-	reviewIds, err = getActorIds(data.ReviewDismissalActorIDs, meta)
+	reviewIds, err = getActorIds(ctx, data.ReviewDismissalActorIDs, meta)
 	if err != nil {
 		return err
 	}
 
-	pushIds, err = getActorIds(data.PushActorIDs, meta)
+	pushIds, err = getActorIds(ctx, data.PushActorIDs, meta)
 	if err != nil {
 		return err
 	}
 
-	bypassForcePushIds, err = getActorIds(data.BypassForcePushActorIDs, meta)
+	bypassForcePushIds, err = getActorIds(ctx, data.BypassForcePushActorIDs, meta)
 	if err != nil {
 		return err
 	}
 
-	bypassPullRequestIds, err = getActorIds(data.BypassPullRequestActorIDs, meta)
+	bypassPullRequestIds, err = getActorIds(ctx, data.BypassPullRequestActorIDs, meta)
 	if err != nil {
 		return err
 	}
@@ -429,7 +437,7 @@ func resourceGithubBranchProtectionUpdate(d *schema.ResourceData, meta interface
 		RequireLastPushApproval:        githubv4.NewBoolean(githubv4.Boolean(data.RequireLastPushApproval)),
 	}
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	client := meta.(*Owner).v4client
 	err = client.Mutate(ctx, &mutate, input, nil)
 	if err != nil {
@@ -442,6 +450,9 @@ func resourceGithubBranchProtectionUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceGithubBranchProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	var mutate struct {
 		DeleteBranchProtectionRule struct { // Empty struct does not work
 			ClientMutationId githubv4.ID
@@ -451,7 +462,7 @@ func resourceGithubBranchProtectionDelete(d *schema.ResourceData, meta interface
 		BranchProtectionRuleID: d.Id(),
 	}
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	client := meta.(*Owner).v4client
 	err := client.Mutate(ctx, &mutate, input, nil)
 
@@ -459,18 +470,20 @@ func resourceGithubBranchProtectionDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceGithubBranchProtectionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx := stopContext(meta)
+
 	repoName, pattern, err := parseTwoPartID(d.Id(), "repository", "pattern")
 	if err != nil {
 		return nil, err
 	}
 
-	repoID, err := getRepositoryID(repoName, meta)
+	repoID, err := getRepositoryID(ctx, repoName, meta)
 	if err != nil {
 		return nil, err
 	}
 	d.Set("repository_id", repoID)
 
-	id, err := getBranchProtectionID(repoID, pattern, meta)
+	id, err := getBranchProtectionID(ctx, repoID, pattern, meta)
 	if err != nil {
 		return nil, err
 	}
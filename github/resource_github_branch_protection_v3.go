@@ -204,6 +204,9 @@ func resourceGithubBranchProtectionV3() *schema.Resource {
 }
 
 func resourceGithubBranchProtectionV3Create(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -219,7 +222,6 @@ func resourceGithubBranchProtectionV3Create(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
-	ctx := context.Background()
 
 	protection, _, err := client.Repositories.UpdateBranchProtection(ctx,
 		orgName,
@@ -237,7 +239,7 @@ func resourceGithubBranchProtectionV3Create(d *schema.ResourceData, meta interfa
 
 	d.SetId(buildTwoPartID(repoName, branch))
 
-	if err = requireSignedCommitsUpdate(d, meta); err != nil {
+	if err = requireSignedCommitsUpdate(ctx, d, meta); err != nil {
 		return err
 	}
 
@@ -245,6 +247,9 @@ func resourceGithubBranchProtectionV3Create(d *schema.ResourceData, meta interfa
 }
 
 func resourceGithubBranchProtectionV3Read(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -258,7 +263,7 @@ func resourceGithubBranchProtectionV3Read(d *schema.ResourceData, meta interface
 	}
 	orgName := meta.(*Owner).name
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -268,7 +273,7 @@ func resourceGithubBranchProtectionV3Read(d *schema.ResourceData, meta interface
 	if err != nil {
 		if ghErr, ok := err.(*github.ErrorResponse); ok {
 			if ghErr.Response.StatusCode == http.StatusNotModified {
-				if err := requireSignedCommitsRead(d, meta); err != nil {
+				if err := requireSignedCommitsRead(ctx, d, meta); err != nil {
 					return fmt.Errorf("error setting signed commit restriction: %v", err)
 				}
 				return nil
@@ -304,7 +309,7 @@ func resourceGithubBranchProtectionV3Read(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error setting restrictions: %v", err)
 	}
 
-	if err := requireSignedCommitsRead(d, meta); err != nil {
+	if err := requireSignedCommitsRead(ctx, d, meta); err != nil {
 		return fmt.Errorf("error setting signed commit restriction: %v", err)
 	}
 
@@ -312,6 +317,9 @@ func resourceGithubBranchProtectionV3Read(d *schema.ResourceData, meta interface
 }
 
 func resourceGithubBranchProtectionV3Update(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -329,7 +337,7 @@ func resourceGithubBranchProtectionV3Update(d *schema.ResourceData, meta interfa
 	}
 
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	protection, _, err := client.Repositories.UpdateBranchProtection(ctx,
 		orgName,
@@ -358,7 +366,7 @@ func resourceGithubBranchProtectionV3Update(d *schema.ResourceData, meta interfa
 
 	d.SetId(buildTwoPartID(repoName, branch))
 
-	if err = requireSignedCommitsUpdate(d, meta); err != nil {
+	if err = requireSignedCommitsUpdate(ctx, d, meta); err != nil {
 		return err
 	}
 
@@ -366,6 +374,9 @@ func resourceGithubBranchProtectionV3Update(d *schema.ResourceData, meta interfa
 }
 
 func resourceGithubBranchProtectionV3Delete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -378,7 +389,7 @@ func resourceGithubBranchProtectionV3Delete(d *schema.ResourceData, meta interfa
 	}
 
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	_, err = client.Repositories.RemoveBranchProtection(ctx,
 		orgName, repoName, branch)
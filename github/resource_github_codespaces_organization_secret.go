@@ -81,9 +81,11 @@ func resourceGithubCodespacesOrganizationSecret() *schema.Resource {
 }
 
 func resourceGithubCodespacesOrganizationSecretCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	secretName := d.Get("secret_name").(string)
 	plaintextValue := d.Get("plaintext_value").(string)
@@ -106,7 +108,7 @@ func resourceGithubCodespacesOrganizationSecretCreateOrUpdate(d *schema.Resource
 		}
 	}
 
-	keyId, publicKey, err := getCodespacesOrganizationPublicKeyDetails(owner, meta)
+	keyId, publicKey, err := getCodespacesOrganizationPublicKeyDetails(ctx, owner, meta)
 	if err != nil {
 		return err
 	}
@@ -140,9 +142,11 @@ func resourceGithubCodespacesOrganizationSecretCreateOrUpdate(d *schema.Resource
 }
 
 func resourceGithubCodespacesOrganizationSecretRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	secret, _, err := client.Codespaces.GetOrgSecret(ctx, owner, d.Id())
 	if err != nil {
@@ -213,18 +217,20 @@ func resourceGithubCodespacesOrganizationSecretRead(d *schema.ResourceData, meta
 }
 
 func resourceGithubCodespacesOrganizationSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting secret: %s", d.Id())
 	_, err := client.Codespaces.DeleteOrgSecret(ctx, orgName, d.Id())
 	return err
 }
 
-func getCodespacesOrganizationPublicKeyDetails(owner string, meta interface{}) (keyId, pkValue string, err error) {
+func getCodespacesOrganizationPublicKeyDetails(ctx context.Context, owner string, meta interface{}) (keyId, pkValue string, err error) {
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	publicKey, _, err := client.Codespaces.GetOrgPublicKey(ctx, owner)
 	if err != nil {
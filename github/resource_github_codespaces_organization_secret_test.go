@@ -16,13 +16,13 @@ func TestAccGithubCodespacesOrganizationSecret(t *testing.T) {
 
 		config := fmt.Sprintf(`
 			resource "github_codespaces_organization_secret" "plaintext_secret" {
-			  secret_name      = "test_plaintext_secret"
+			  secret_name      = "tf_acc_test_plaintext_secret"
 			  plaintext_value  = "%s"
 			  visibility       = "private"
 			}
 
 			resource "github_codespaces_organization_secret" "encrypted_secret" {
-			  secret_name      = "test_encrypted_secret"
+			  secret_name      = "tf_acc_test_encrypted_secret"
 			  encrypted_value  = "%s"
 			  visibility       = "private"
 			}
@@ -98,12 +98,12 @@ func TestAccGithubCodespacesOrganizationSecret(t *testing.T) {
 	t.Run("deletes secrets without error", func(t *testing.T) {
 		config := `
 				resource "github_codespaces_organization_secret" "plaintext_secret" {
-					secret_name      = "test_plaintext_secret"
+					secret_name      = "tf_acc_test_plaintext_secret"
 					visibility       = "private"
 				}
 
 				resource "github_codespaces_organization_secret" "encrypted_secret" {
-					secret_name      = "test_encrypted_secret"
+					secret_name      = "tf_acc_test_encrypted_secret"
 					visibility       = "private"
 				}
 			`
@@ -139,7 +139,7 @@ func TestAccGithubCodespacesOrganizationSecret(t *testing.T) {
 
 		config := fmt.Sprintf(`
 			resource "github_codespaces_organization_secret" "test_secret" {
-				secret_name      = "test_plaintext_secret"
+				secret_name      = "tf_acc_test_plaintext_secret"
 				plaintext_value  = "%s"
 				visibility       = "private"
 			}
@@ -184,3 +184,19 @@ func TestAccGithubCodespacesOrganizationSecret(t *testing.T) {
 		})
 	})
 }
+
+func testSweepCodespacesOrganizationSecrets(region string) error {
+	owner, err := testSweepOrganization(region)
+	if err != nil {
+		return err
+	}
+	return testSweepOrganizationSecrets(owner, "Codespaces secret",
+		owner.v3client.Codespaces.ListOrgSecrets, owner.v3client.Codespaces.DeleteOrgSecret)
+}
+
+func init() {
+	resource.AddTestSweepers("github_codespaces_organization_secret", &resource.Sweeper{
+		Name: "github_codespaces_organization_secret",
+		F:    testSweepCodespacesOrganizationSecrets,
+	})
+}
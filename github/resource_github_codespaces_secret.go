@@ -66,16 +66,18 @@ func resourceGithubCodespacesSecret() *schema.Resource {
 }
 
 func resourceGithubCodespacesSecretCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repo := d.Get("repository").(string)
 	secretName := d.Get("secret_name").(string)
 	plaintextValue := d.Get("plaintext_value").(string)
 	var encryptedValue string
 
-	keyId, publicKey, err := getCodespacesPublicKeyDetails(owner, repo, meta)
+	keyId, publicKey, err := getCodespacesPublicKeyDetails(ctx, owner, repo, meta)
 	if err != nil {
 		return err
 	}
@@ -107,9 +109,11 @@ func resourceGithubCodespacesSecretCreateOrUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceGithubCodespacesSecretRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repoName, secretName, err := parseTwoPartID(d.Id(), "repository", "secret_name")
 	if err != nil {
@@ -159,9 +163,12 @@ func resourceGithubCodespacesSecretRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGithubCodespacesSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	repoName, secretName, err := parseTwoPartID(d.Id(), "repository", "secret_name")
 	if err != nil {
@@ -175,9 +182,10 @@ func resourceGithubCodespacesSecretDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceGithubCodespacesSecretImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 {
@@ -207,9 +215,8 @@ func resourceGithubCodespacesSecretImport(d *schema.ResourceData, meta interface
 	return []*schema.ResourceData{d}, nil
 }
 
-func getCodespacesPublicKeyDetails(owner, repository string, meta interface{}) (keyId, pkValue string, err error) {
+func getCodespacesPublicKeyDetails(ctx context.Context, owner, repository string, meta interface{}) (keyId, pkValue string, err error) {
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	publicKey, _, err := client.Codespaces.GetRepoPublicKey(ctx, owner, repository)
 	if err != nil {
@@ -73,8 +73,10 @@ func resourceGithubCodespacesUserSecret() *schema.Resource {
 }
 
 func resourceGithubCodespacesUserSecretCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	secretName := d.Get("secret_name").(string)
 	plaintextValue := d.Get("plaintext_value").(string)
@@ -92,7 +94,7 @@ func resourceGithubCodespacesUserSecretCreateOrUpdate(d *schema.ResourceData, me
 		}
 	}
 
-	keyId, publicKey, err := getCodespacesUserPublicKeyDetails(meta)
+	keyId, publicKey, err := getCodespacesUserPublicKeyDetails(ctx, meta)
 	if err != nil {
 		return err
 	}
@@ -125,8 +127,10 @@ func resourceGithubCodespacesUserSecretCreateOrUpdate(d *schema.ResourceData, me
 }
 
 func resourceGithubCodespacesUserSecretRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	secret, _, err := client.Codespaces.GetUserSecret(ctx, d.Id())
 	if err != nil {
@@ -194,17 +198,19 @@ func resourceGithubCodespacesUserSecretRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceGithubCodespacesUserSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting secret: %s", d.Id())
 	_, err := client.Codespaces.DeleteUserSecret(ctx, d.Id())
 	return err
 }
 
-func getCodespacesUserPublicKeyDetails(meta interface{}) (keyId, pkValue string, err error) {
+func getCodespacesUserPublicKeyDetails(ctx context.Context, meta interface{}) (keyId, pkValue string, err error) {
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	publicKey, _, err := client.Codespaces.GetUserPublicKey(ctx)
 	if err != nil {
@@ -16,13 +16,13 @@ func TestAccGithubDependabotOrganizationSecret(t *testing.T) {
 
 		config := fmt.Sprintf(`
 			resource "github_dependabot_organization_secret" "plaintext_secret" {
-			  secret_name      = "test_plaintext_secret"
+			  secret_name      = "tf_acc_test_plaintext_secret"
 			  plaintext_value  = "%s"
 			  visibility       = "private"
 			}
 
 			resource "github_dependabot_organization_secret" "encrypted_secret" {
-			  secret_name      = "test_encrypted_secret"
+			  secret_name      = "tf_acc_test_encrypted_secret"
 			  encrypted_value  = "%s"
 			  visibility       = "private"
 			}
@@ -98,12 +98,12 @@ func TestAccGithubDependabotOrganizationSecret(t *testing.T) {
 	t.Run("deletes secrets without error", func(t *testing.T) {
 		config := `
 				resource "github_dependabot_organization_secret" "plaintext_secret" {
-					secret_name      = "test_plaintext_secret"
+					secret_name      = "tf_acc_test_plaintext_secret"
 					visibility       = "private"
 				}
 
 				resource "github_dependabot_organization_secret" "encrypted_secret" {
-					secret_name      = "test_encrypted_secret"
+					secret_name      = "tf_acc_test_encrypted_secret"
 					visibility       = "private"
 				}
 			`
@@ -139,7 +139,7 @@ func TestAccGithubDependabotOrganizationSecret(t *testing.T) {
 
 		config := fmt.Sprintf(`
 			resource "github_dependabot_organization_secret" "test_secret" {
-				secret_name      = "test_plaintext_secret"
+				secret_name      = "tf_acc_test_plaintext_secret"
 				plaintext_value  = "%s"
 				visibility       = "private"
 			}
@@ -184,3 +184,19 @@ func TestAccGithubDependabotOrganizationSecret(t *testing.T) {
 		})
 	})
 }
+
+func testSweepDependabotOrganizationSecrets(region string) error {
+	owner, err := testSweepOrganization(region)
+	if err != nil {
+		return err
+	}
+	return testSweepOrganizationSecrets(owner, "Dependabot secret",
+		owner.v3client.Dependabot.ListOrgSecrets, owner.v3client.Dependabot.DeleteOrgSecret)
+}
+
+func init() {
+	resource.AddTestSweepers("github_dependabot_organization_secret", &resource.Sweeper{
+		Name: "github_dependabot_organization_secret",
+		F:    testSweepDependabotOrganizationSecrets,
+	})
+}
@@ -67,16 +67,18 @@ func resourceGithubDependabotSecret() *schema.Resource {
 }
 
 func resourceGithubDependabotSecretCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repo := d.Get("repository").(string)
 	secretName := d.Get("secret_name").(string)
 	plaintextValue := d.Get("plaintext_value").(string)
 	var encryptedValue string
 
-	keyId, publicKey, err := getDependabotPublicKeyDetails(owner, repo, meta)
+	keyId, publicKey, err := getDependabotPublicKeyDetails(ctx, owner, repo, meta)
 	if err != nil {
 		return err
 	}
@@ -108,9 +110,11 @@ func resourceGithubDependabotSecretCreateOrUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceGithubDependabotSecretRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repoName, secretName, err := parseTwoPartID(d.Id(), "repository", "secret_name")
 	if err != nil {
@@ -160,9 +164,12 @@ func resourceGithubDependabotSecretRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGithubDependabotSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	repoName, secretName, err := parseTwoPartID(d.Id(), "repository", "secret_name")
 	if err != nil {
@@ -176,9 +183,10 @@ func resourceGithubDependabotSecretDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceGithubDependabotSecretImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx := stopContext(meta)
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 {
@@ -208,9 +216,8 @@ func resourceGithubDependabotSecretImport(d *schema.ResourceData, meta interface
 	return []*schema.ResourceData{d}, nil
 }
 
-func getDependabotPublicKeyDetails(owner, repository string, meta interface{}) (keyId, pkValue string, err error) {
+func getDependabotPublicKeyDetails(ctx context.Context, owner, repository string, meta interface{}) (keyId, pkValue string, err error) {
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	publicKey, _, err := client.Dependabot.GetRepoPublicKey(ctx, owner, repository)
 	if err != nil {
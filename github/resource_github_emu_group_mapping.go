@@ -24,7 +24,7 @@ func resourceGithubEMUGroupMapping() *schema.Resource {
 				if err := d.Set("group_id", id); err != nil {
 					return nil, err
 				}
-				ctx := context.WithValue(context.Background(), ctxId, d.Id())
+				ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 				client := meta.(*Owner).v3client
 				orgName := meta.(*Owner).name
 				group, _, err := client.Teams.GetExternalGroup(ctx, orgName, int64(id))
@@ -65,6 +65,9 @@ func resourceGithubEMUGroupMappingCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGithubEMUGroupMappingRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -81,7 +84,7 @@ func resourceGithubEMUGroupMappingRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	group, resp, err := client.Teams.GetExternalGroup(ctx, orgName, id64)
 	if err != nil {
@@ -101,13 +104,16 @@ func resourceGithubEMUGroupMappingRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubEMUGroupMappingUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
 	}
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	teamSlug, ok := d.GetOk("team_slug")
 	if !ok {
@@ -137,6 +143,9 @@ func resourceGithubEMUGroupMappingUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceGithubEMUGroupMappingDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -149,7 +158,7 @@ func resourceGithubEMUGroupMappingDelete(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("could not parse team slug from provided value")
 	}
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	_, err = client.Teams.RemoveConnectedExternalGroup(ctx, orgName, teamSlug.(string))
 	if err != nil {
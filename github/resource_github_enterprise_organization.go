@@ -57,6 +57,9 @@ func resourceGithubEnterpriseOrganization() *schema.Resource {
 }
 
 func resourceGithubEnterpriseOrganizationCreate(data *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(data, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	var mutate struct {
 		CreateEnterpriseOrganization struct {
 			Organization struct {
@@ -82,7 +85,7 @@ func resourceGithubEnterpriseOrganizationCreate(data *schema.ResourceData, meta
 		AdminLogins:  adminLogins,
 	}
 
-	err := v4.Mutate(context.Background(), &mutate, input, nil)
+	err := v4.Mutate(ctx, &mutate, input, nil)
 	if err != nil {
 		return err
 	}
@@ -105,7 +108,7 @@ func resourceGithubEnterpriseOrganizationCreate(data *schema.ResourceData, meta
 	description := data.Get("description").(string)
 	if description != "" {
 		_, _, err = v3.Organizations.Edit(
-			context.Background(),
+			ctx,
 			data.Get("name").(string),
 			&github.Organization{
 				Description: github.String(description),
@@ -118,6 +121,9 @@ func resourceGithubEnterpriseOrganizationCreate(data *schema.ResourceData, meta
 }
 
 func resourceGithubEnterpriseOrganizationRead(data *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(data, meta, schema.TimeoutRead)
+	defer cancel()
+
 	var query struct {
 		Node struct {
 			Organization struct {
@@ -147,7 +153,7 @@ func resourceGithubEnterpriseOrganizationRead(data *schema.ResourceData, meta in
 
 	for {
 		v4 := meta.(*Owner).v4client
-		err := v4.Query(context.Background(), &query, variables)
+		err := v4.Query(ctx, &query, variables)
 		if err != nil {
 			if strings.Contains(err.Error(), "Could not resolve to a node with the global id") {
 				log.Printf("[INFO] Removing organization (%s) from state because it no longer exists in GitHub", data.Id())
@@ -190,10 +196,13 @@ func resourceGithubEnterpriseOrganizationRead(data *schema.ResourceData, meta in
 }
 
 func resourceGithubEnterpriseOrganizationDelete(data *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(data, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	owner := meta.(*Owner)
 	v3 := owner.v3client
 
-	ctx := context.WithValue(context.Background(), ctxId, data.Id())
+	ctx = context.WithValue(ctx, ctxId, data.Id())
 
 	_, err := v3.Organizations.Delete(ctx, data.Get("name").(string))
 
@@ -299,7 +308,7 @@ func addUsers(ctx context.Context, data *schema.ResourceData, v4 *githubv4.Clien
 		}
 
 		adminRole := githubv4.OrganizationMemberRoleAdmin
-		userIds, err := getUserIds(v4, toAdd)
+		userIds, err := getUserIds(ctx, v4, toAdd)
 		if err != nil {
 			return err
 		}
@@ -338,9 +347,11 @@ func updateBillingEmail(ctx context.Context, data *schema.ResourceData, orgName
 }
 
 func resourceGithubEnterpriseOrganizationUpdate(data *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(data, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	v3 := meta.(*Owner).v3client
 	v4 := meta.(*Owner).v4client
-	ctx := context.Background()
 
 	err := updateDescription(ctx, data, v3)
 	if err != nil {
@@ -356,7 +367,7 @@ func resourceGithubEnterpriseOrganizationUpdate(data *schema.ResourceData, meta
 	return updateBillingEmail(ctx, data, orgName, v3)
 }
 
-func getUserIds(v4 *githubv4.Client, loginNames []interface{}) ([]githubv4.ID, error) {
+func getUserIds(ctx context.Context, v4 *githubv4.Client, loginNames []interface{}) ([]githubv4.ID, error) {
 	var query struct {
 		User struct {
 			ID githubv4.String
@@ -366,7 +377,7 @@ func getUserIds(v4 *githubv4.Client, loginNames []interface{}) ([]githubv4.ID, e
 	var ret []githubv4.ID
 
 	for _, l := range loginNames {
-		err := v4.Query(context.Background(), &query, map[string]interface{}{"login": githubv4.String(l.(string))})
+		err := v4.Query(ctx, &query, map[string]interface{}{"login": githubv4.String(l.(string))})
 		if err != nil {
 			return nil, err
 		}
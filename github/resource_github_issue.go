@@ -66,6 +66,11 @@ func resourceGithubIssue() *schema.Resource {
 				Computed:    true,
 				Description: "The issue id.",
 			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Node ID of the issue.",
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -75,7 +80,9 @@ func resourceGithubIssue() *schema.Resource {
 }
 
 func resourceGithubIssueCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.Background()
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
@@ -129,6 +136,9 @@ func resourceGithubIssueCreateOrUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubIssueRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	repoName, idNumber, err := parseTwoPartID(d.Id(), "repository", "issue_number")
 	if err != nil {
@@ -141,7 +151,7 @@ func resourceGithubIssueRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -170,6 +180,7 @@ func resourceGithubIssueRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("title", issue.GetTitle())
 	d.Set("body", issue.GetBody())
 	d.Set("milestone_number", issue.GetMilestone().GetNumber())
+	d.Set("node_id", issue.GetNodeID())
 
 	var labels []string
 	for _, v := range issue.Labels {
@@ -188,12 +199,15 @@ func resourceGithubIssueRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGithubIssueDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	orgName := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
 	number := d.Get("number").(int)
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting issue by closing: %d (%s/%s)", number, orgName, repoName)
 
@@ -0,0 +1,160 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceGithubOrganizationCustomProperty() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationCustomPropertyCreateOrUpdate,
+		Read:   resourceGithubOrganizationCustomPropertyRead,
+		Update: resourceGithubOrganizationCustomPropertyCreateOrUpdate,
+		Delete: resourceGithubOrganizationCustomPropertyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: requireGHESVersionDiff("3.13", "github_organization_custom_property"),
+		Schema: map[string]*schema.Schema{
+			"property_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the custom property.",
+			},
+			"value_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(customPropertyValueTypes, false),
+				Description:  "The type of the values of the custom property: `string`, `single_select`, `multi_select` or `true_false`.",
+			},
+			"required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether every repository must have a value for the custom property.",
+			},
+			"default_value": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The default value of the custom property, given to repositories without a value. Only `multi_select` properties take more than one value.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A description of the custom property.",
+			},
+			"allowed_values": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The values `single_select` and `multi_select` properties can take.",
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationCustomPropertyCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+	name := d.Get("property_name").(string)
+
+	valueType := d.Get("value_type").(string)
+	defaultValue, err := encodeCustomPropertyValue(valueType, expandStringList(d.Get("default_value").(*schema.Set).List()))
+	if err != nil {
+		return fmt.Errorf("invalid default_value of custom property %s: %s", name, err)
+	}
+
+	property := &customProperty{
+		ValueType:     valueType,
+		Required:      d.Get("required").(bool),
+		DefaultValue:  defaultValue,
+		Description:   d.Get("description").(string),
+		AllowedValues: expandStringList(d.Get("allowed_values").([]interface{})),
+	}
+
+	_, err = customPropertyRequest(ctx, client, "PUT", customPropertySchemaPath(orgName, name), property, nil)
+	if err != nil {
+		return fmt.Errorf("error saving custom property %s of organization %s: %s", name, orgName, err)
+	}
+
+	d.SetId(name)
+
+	return resourceGithubOrganizationCustomPropertyRead(d, meta)
+}
+
+func resourceGithubOrganizationCustomPropertyRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	var property customProperty
+	_, err = customPropertyRequest(ctx, client, "GET", customPropertySchemaPath(orgName, d.Id()), nil, &property)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing custom property %s from state because it no longer exists in GitHub",
+					d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	defaultValue, err := decodeCustomPropertyValue(property.DefaultValue)
+	if err != nil {
+		return fmt.Errorf("unable to decode the default value of custom property %s: %s", d.Id(), err)
+	}
+
+	d.Set("property_name", d.Id())
+	d.Set("value_type", property.ValueType)
+	d.Set("required", property.Required)
+	d.Set("default_value", defaultValue)
+	d.Set("description", property.Description)
+	d.Set("allowed_values", property.AllowedValues)
+
+	return nil
+}
+
+func resourceGithubOrganizationCustomPropertyDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	_, err = customPropertyRequest(ctx, client, "DELETE", customPropertySchemaPath(orgName, d.Id()), nil, nil)
+	return err
+}
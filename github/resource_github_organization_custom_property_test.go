@@ -0,0 +1,113 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("github_organization_custom_property", &resource.Sweeper{
+		Name: "github_organization_custom_property",
+		F:    testSweepOrganizationCustomProperties,
+	})
+}
+
+func testSweepOrganizationCustomProperties(region string) error {
+	owner, err := testSweepOrganization(region)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	var properties []*customProperty
+	_, err = customPropertyRequest(ctx, owner.v3client, "GET", fmt.Sprintf("orgs/%s/properties/schema", owner.name), nil, &properties)
+	if err != nil {
+		return err
+	}
+
+	var errs []string
+	for _, p := range properties {
+		if !strings.HasPrefix(p.PropertyName, testSweepPrefix) {
+			continue
+		}
+		name := p.PropertyName
+		if err := testSweep("custom property", name, func() (*github.Response, error) {
+			return customPropertyRequest(ctx, owner.v3client, "DELETE", customPropertySchemaPath(owner.name, name), nil, nil)
+		}); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	return testSweepErrors(errs)
+}
+
+func TestAccGithubOrganizationCustomProperty(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates and updates custom properties without error", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_organization_custom_property" "test" {
+				property_name  = "tf-acc-test-%s"
+				value_type     = "single_select"
+				allowed_values = ["high", "low"]
+				default_value  = ["%%s"]
+				required       = true
+			}
+		`, randomID)
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_organization_custom_property.test", "value_type", "single_select"),
+				resource.TestCheckResourceAttr("github_organization_custom_property.test", "allowed_values.#", "2"),
+				resource.TestCheckResourceAttr("github_organization_custom_property.test", "required", "true"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_organization_custom_property.test", "default_value.#", "1"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, "low"),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, "high"),
+						Check:  checks["after"],
+					},
+					{
+						Config:            fmt.Sprintf(config, "high"),
+						ResourceName:      "github_organization_custom_property.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 	"log"
 
@@ -48,9 +47,11 @@ func resourceGithubOrganizationCustomRole() *schema.Resource {
 }
 
 func resourceGithubOrganizationCustomRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
-	ctx := context.Background()
 
 	err := checkOrganization(meta)
 	if err != nil {
@@ -79,8 +80,10 @@ func resourceGithubOrganizationCustomRoleCreate(d *schema.ResourceData, meta int
 }
 
 func resourceGithubOrganizationCustomRoleRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 	orgName := meta.(*Owner).name
 
 	err := checkOrganization(meta)
@@ -121,8 +124,10 @@ func resourceGithubOrganizationCustomRoleRead(d *schema.ResourceData, meta inter
 }
 
 func resourceGithubOrganizationCustomRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 	orgName := meta.(*Owner).name
 
 	err := checkOrganization(meta)
@@ -152,8 +157,10 @@ func resourceGithubOrganizationCustomRoleUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceGithubOrganizationCustomRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 	orgName := meta.(*Owner).name
 
 	err := checkOrganization(meta)
@@ -46,6 +46,9 @@ func resourceGithubOrganizationProject() *schema.Resource {
 }
 
 func resourceGithubOrganizationProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -55,7 +58,6 @@ func resourceGithubOrganizationProjectCreate(d *schema.ResourceData, meta interf
 	orgName := meta.(*Owner).name
 	name := d.Get("name").(string)
 	body := d.Get("body").(string)
-	ctx := context.Background()
 
 	project, _, err := client.Organizations.CreateProject(ctx,
 		orgName,
@@ -73,6 +75,9 @@ func resourceGithubOrganizationProjectCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceGithubOrganizationProjectRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -85,7 +90,7 @@ func resourceGithubOrganizationProjectRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -116,6 +121,9 @@ func resourceGithubOrganizationProjectRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceGithubOrganizationProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -135,7 +143,7 @@ func resourceGithubOrganizationProjectUpdate(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	if _, _, err := client.Projects.UpdateProject(ctx, projectID, &options); err != nil {
 		return err
@@ -145,6 +153,9 @@ func resourceGithubOrganizationProjectUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceGithubOrganizationProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -155,7 +166,7 @@ func resourceGithubOrganizationProjectDelete(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	_, err = client.Projects.DeleteProject(ctx, projectID)
 	return err
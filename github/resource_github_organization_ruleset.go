@@ -0,0 +1,292 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceGithubOrganizationRuleset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationRulesetCreate,
+		Read:   resourceGithubOrganizationRulesetRead,
+		Update: resourceGithubOrganizationRulesetUpdate,
+		Delete: resourceGithubOrganizationRulesetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubOrganizationRulesetImport,
+		},
+		CustomizeDiff: requireGHESVersionDiff("3.11", "github_organization_ruleset"),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the ruleset.",
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"branch", "tag"}, false),
+				Description:  "The refs the ruleset applies to, either `branch` or `tag`.",
+			},
+			"enforcement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"disabled", "active", "evaluate"}, false),
+				Description:  "The enforcement of the ruleset: `disabled`, `active`, or `evaluate` to only report violations.",
+			},
+			"bypass_actors": rulesetBypassActorsSchema(),
+			"conditions": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The repositories and refs the ruleset applies to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ref_name": rulesetRefNameSchema(),
+						"repository_name": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"conditions.0.repository_name", "conditions.0.repository_id"},
+							Description:  "The names of the repositories the ruleset applies to.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Repository names or patterns to include. `~ALL` matches every repository.",
+									},
+									"exclude": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Repository names or patterns to exclude.",
+									},
+									"protected": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Whether renaming a matching repository is prevented for users without bypass permission.",
+									},
+								},
+							},
+						},
+						"repository_id": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ExactlyOneOf: []string{"conditions.0.repository_name", "conditions.0.repository_id"},
+							Elem:         &schema.Schema{Type: schema.TypeInt},
+							Description:  "The IDs of the repositories the ruleset applies to.",
+						},
+					},
+				},
+			},
+			"rules": rulesetRulesSchema(),
+			"ruleset_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the ruleset.",
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The GraphQL node ID of the ruleset.",
+			},
+		},
+	}
+}
+
+func expandOrganizationRuleset(d *schema.ResourceData) *ruleset {
+	rs := &ruleset{
+		Name:         d.Get("name").(string),
+		Target:       d.Get("target").(string),
+		Enforcement:  d.Get("enforcement").(string),
+		BypassActors: expandRulesetBypassActors(d.Get("bypass_actors")),
+		Rules:        expandRulesetRules(d.Get("rules")),
+	}
+
+	conditions := d.Get("conditions").([]interface{})[0].(map[string]interface{})
+	rs.Conditions = &rulesetConditions{
+		RefName: expandRulesetRefName(conditions["ref_name"]),
+	}
+
+	if v, ok := conditions["repository_name"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		repositoryName := v[0].(map[string]interface{})
+		rs.Conditions.RepositoryName = &rulesetRepositoryNameCondition{
+			Include:   expandStringList(repositoryName["include"].([]interface{})),
+			Exclude:   expandStringList(repositoryName["exclude"].([]interface{})),
+			Protected: repositoryName["protected"].(bool),
+		}
+	}
+
+	if v, ok := conditions["repository_id"].([]interface{}); ok && len(v) > 0 {
+		repositoryIDs := make([]int64, 0, len(v))
+		for _, id := range v {
+			repositoryIDs = append(repositoryIDs, int64(id.(int)))
+		}
+		rs.Conditions.RepositoryID = &rulesetRepositoryIDCondition{RepositoryIDs: repositoryIDs}
+	}
+
+	return rs
+}
+
+func flattenOrganizationRulesetConditions(conditions *rulesetConditions) []interface{} {
+	if conditions == nil {
+		return []interface{}{}
+	}
+
+	c := map[string]interface{}{
+		"ref_name":        flattenRulesetRefName(conditions.RefName),
+		"repository_name": []interface{}{},
+		"repository_id":   []interface{}{},
+	}
+
+	if conditions.RepositoryName != nil {
+		c["repository_name"] = []interface{}{
+			map[string]interface{}{
+				"include":   conditions.RepositoryName.Include,
+				"exclude":   conditions.RepositoryName.Exclude,
+				"protected": conditions.RepositoryName.Protected,
+			},
+		}
+	}
+
+	if conditions.RepositoryID != nil {
+		repositoryIDs := make([]interface{}, 0, len(conditions.RepositoryID.RepositoryIDs))
+		for _, id := range conditions.RepositoryID.RepositoryIDs {
+			repositoryIDs = append(repositoryIDs, int(id))
+		}
+		c["repository_id"] = repositoryIDs
+	}
+
+	return []interface{}{c}
+}
+
+func resourceGithubOrganizationRulesetPath(org, rulesetID string) string {
+	path := fmt.Sprintf("orgs/%s/rulesets", org)
+	if rulesetID != "" {
+		path += "/" + rulesetID
+	}
+	return path
+}
+
+func resourceGithubOrganizationRulesetCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	rs, _, err := rulesetRequest(ctx, client, "POST", resourceGithubOrganizationRulesetPath(orgName, ""), expandOrganizationRuleset(d))
+	if err != nil {
+		return fmt.Errorf("error creating ruleset %s in organization %s: %s", d.Get("name").(string), orgName, err)
+	}
+
+	d.SetId(strconv.FormatInt(rs.ID, 10))
+
+	return resourceGithubOrganizationRulesetRead(d, meta)
+}
+
+func resourceGithubOrganizationRulesetRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	rs, _, err := rulesetRequest(ctx, client, "GET", resourceGithubOrganizationRulesetPath(orgName, d.Id()), nil)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing organization ruleset %s from state because it no longer exists in GitHub",
+					d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	rules, err := flattenRulesetRules(rs.Rules)
+	if err != nil {
+		return fmt.Errorf("error reading the rules of organization ruleset %s: %s", d.Id(), err)
+	}
+
+	d.Set("ruleset_id", int(rs.ID))
+	d.Set("node_id", rs.NodeID)
+	d.Set("name", rs.Name)
+	d.Set("target", rs.Target)
+	d.Set("enforcement", rs.Enforcement)
+	d.Set("bypass_actors", flattenRulesetBypassActors(rs.BypassActors))
+	d.Set("conditions", flattenOrganizationRulesetConditions(rs.Conditions))
+	d.Set("rules", rules)
+
+	return nil
+}
+
+func resourceGithubOrganizationRulesetUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	_, _, err = rulesetRequest(ctx, client, "PUT", resourceGithubOrganizationRulesetPath(orgName, d.Id()), expandOrganizationRuleset(d))
+	if err != nil {
+		return fmt.Errorf("error updating organization ruleset %s: %s", d.Id(), err)
+	}
+
+	return resourceGithubOrganizationRulesetRead(d, meta)
+}
+
+func resourceGithubOrganizationRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	_, _, err = rulesetRequest(ctx, client, "DELETE", resourceGithubOrganizationRulesetPath(orgName, d.Id()), nil)
+	return err
+}
+
+func resourceGithubOrganizationRulesetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.ParseInt(d.Id(), 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid ruleset ID %q: %s", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
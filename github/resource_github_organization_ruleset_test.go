@@ -0,0 +1,155 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("github_organization_ruleset", &resource.Sweeper{
+		Name: "github_organization_ruleset",
+		F:    testSweepOrganizationRulesets,
+	})
+}
+
+func testSweepOrganizationRulesets(region string) error {
+	owner, err := testSweepOrganization(region)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	rulesets, err := listRulesets(ctx, owner.v3client, resourceGithubOrganizationRulesetPath(owner.name, ""), url.Values{})
+	if err != nil {
+		return err
+	}
+
+	var errs []string
+	for _, rs := range rulesets {
+		if !strings.HasPrefix(rs.Name, testSweepPrefix) || rs.SourceType != "Organization" {
+			continue
+		}
+		rulesetID := strconv.FormatInt(rs.ID, 10)
+		if err := testSweep("organization ruleset", rs.Name, func() (*github.Response, error) {
+			_, resp, err := rulesetRequest(ctx, owner.v3client, "DELETE", resourceGithubOrganizationRulesetPath(owner.name, rulesetID), nil)
+			return resp, err
+		}); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	return testSweepErrors(errs)
+}
+
+func TestAccGithubOrganizationRuleset(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates and updates organization rulesets without error", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_team" "test" {
+				name = "tf-acc-test-%[1]s"
+			}
+
+			resource "github_organization_ruleset" "test" {
+				name        = "tf-acc-test-%[1]s"
+				target      = "branch"
+				enforcement = "%%s"
+
+				bypass_actors {
+					actor_id    = github_team.test.id
+					actor_type  = "Team"
+					bypass_mode = "pull_request"
+				}
+
+				bypass_actors {
+					actor_id   = 1
+					actor_type = "OrganizationAdmin"
+				}
+
+				conditions {
+					ref_name {
+						include = ["~DEFAULT_BRANCH"]
+						exclude = []
+					}
+
+					repository_name {
+						include = ["tf-acc-test-%[1]s-*"]
+						exclude = []
+					}
+				}
+
+				rules {
+					deletion         = true
+					non_fast_forward = true
+
+					pull_request {
+						required_approving_review_count = 1
+						dismiss_stale_reviews_on_push   = true
+					}
+
+					commit_author_email_pattern {
+						operator = "ends_with"
+						pattern  = "@example.com"
+					}
+				}
+			}
+		`, randomID)
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_organization_ruleset.test", "enforcement", "evaluate"),
+				resource.TestCheckResourceAttr("github_organization_ruleset.test", "bypass_actors.#", "2"),
+				resource.TestCheckResourceAttrSet("github_organization_ruleset.test", "ruleset_id"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_organization_ruleset.test", "enforcement", "active"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, "evaluate"),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, "active"),
+						Check:  checks["after"],
+					},
+					{
+						Config:            fmt.Sprintf(config, "active"),
+						ResourceName:      "github_organization_ruleset.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
@@ -31,6 +31,9 @@ func resourceGithubOrganizationSecurityManager() *schema.Resource {
 }
 
 func resourceGithubOrganizationSecurityManagerCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -40,7 +43,6 @@ func resourceGithubOrganizationSecurityManagerCreate(d *schema.ResourceData, met
 	teamSlug := d.Get("team_slug").(string)
 
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 
 	team, _, err := client.Teams.GetTeamBySlug(ctx, orgName, teamSlug)
 	if err != nil {
@@ -65,6 +67,9 @@ func resourceGithubOrganizationSecurityManagerCreate(d *schema.ResourceData, met
 }
 
 func resourceGithubOrganizationSecurityManagerRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -77,7 +82,7 @@ func resourceGithubOrganizationSecurityManagerRead(d *schema.ResourceData, meta
 	}
 
 	client := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	// There is no endpoint for getting a single security manager team, so get the list and filter.
 	// There is a maximum number of security manager teams (currently 10), so this should be fine.
@@ -106,6 +111,9 @@ func resourceGithubOrganizationSecurityManagerRead(d *schema.ResourceData, meta
 }
 
 func resourceGithubOrganizationSecurityManagerUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -119,7 +127,7 @@ func resourceGithubOrganizationSecurityManagerUpdate(d *schema.ResourceData, met
 	}
 
 	client := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	team, _, err := client.Teams.GetTeamByID(ctx, orgId, teamId)
 	if err != nil {
@@ -136,6 +144,9 @@ func resourceGithubOrganizationSecurityManagerUpdate(d *schema.ResourceData, met
 }
 
 func resourceGithubOrganizationSecurityManagerDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -145,7 +156,7 @@ func resourceGithubOrganizationSecurityManagerDelete(d *schema.ResourceData, met
 	teamSlug := d.Get("team_slug").(string)
 
 	client := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	_, err = client.Organizations.RemoveSecurityManagerTeam(ctx, orgName, teamSlug)
 	return err
@@ -173,12 +173,15 @@ func resourceGithubOrganizationSettings() *schema.Resource {
 }
 
 func resourceGithubOrganizationSettingsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
 	}
 	client := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	org := meta.(*Owner).name
 
 	settings := github.Organization{
@@ -300,12 +303,14 @@ func resourceGithubOrganizationSettingsCreateOrUpdate(d *schema.ResourceData, me
 }
 
 func resourceGithubOrganizationSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
 	}
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 	org := meta.(*Owner).name
 
 	orgSettings, _, err := client.Organizations.Get(ctx, org)
@@ -344,13 +349,16 @@ func resourceGithubOrganizationSettingsRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceGithubOrganizationSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
 	}
 
 	client := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	org := meta.(*Owner).name
 
 	// This will set org settings to default values
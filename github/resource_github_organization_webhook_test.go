@@ -1,10 +1,12 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 )
@@ -141,3 +143,48 @@ func TestAccGithubOrganizationWebhook(t *testing.T) {
 	})
 
 }
+
+func testSweepOrganizationWebhooks(region string) error {
+	owner, err := testSweepOrganization(region)
+	if err != nil {
+		return err
+	}
+	client := owner.v3client
+	ctx := context.Background()
+
+	var errs []string
+	opts := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		hooks, resp, err := client.Organizations.ListHooks(ctx, owner.name, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, hook := range hooks {
+			url, _ := hook.Config["url"].(string)
+			id := hook.GetID()
+			if !strings.HasPrefix(url, testSweepWebhookURLPrefix) {
+				continue
+			}
+			if err := testSweep("organization webhook", fmt.Sprintf("%d (%s)", id, url), func() (*github.Response, error) {
+				return client.Organizations.DeleteHook(ctx, owner.name, id)
+			}); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return testSweepErrors(errs)
+}
+
+func init() {
+	resource.AddTestSweepers("github_organization_webhook", &resource.Sweeper{
+		Name: "github_organization_webhook",
+		F:    testSweepOrganizationWebhooks,
+	})
+}
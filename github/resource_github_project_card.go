@@ -57,6 +57,9 @@ func resourceGithubProjectCard() *schema.Resource {
 }
 
 func resourceGithubProjectCardCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -86,7 +89,6 @@ func resourceGithubProjectCardCreate(d *schema.ResourceData, meta interface{}) e
 			return fmt.Errorf("content_type must be set to either Issue or PullRequest")
 		}
 	}
-	ctx := context.Background()
 	card, _, err := client.Projects.CreateProjectCard(ctx, columnID, &options)
 	if err != nil {
 		return err
@@ -99,10 +101,13 @@ func resourceGithubProjectCardCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGithubProjectCardRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	nodeID := d.Id()
 	cardID := d.Get("card_id").(int)
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -135,6 +140,9 @@ func resourceGithubProjectCardRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceGithubProjectCardUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	cardID := d.Get("card_id").(int)
 
@@ -152,7 +160,7 @@ func resourceGithubProjectCardUpdate(d *schema.ResourceData, meta interface{}) e
 
 		options.ContentType = d.Get("content_type").(string)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	_, _, err := client.Projects.UpdateProjectCard(ctx, int64(cardID), &options)
 	if err != nil {
 		return err
@@ -162,8 +170,11 @@ func resourceGithubProjectCardUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGithubProjectCardDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting project Card: %s", d.Id())
 	cardID := d.Get("card_id").(int)
@@ -176,6 +187,7 @@ func resourceGithubProjectCardDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGithubProjectCardImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx := stopContext(meta)
 
 	cardIDStr := d.Id()
 	cardID, err := strconv.ParseInt(cardIDStr, 10, 64)
@@ -185,7 +197,6 @@ func resourceGithubProjectCardImport(d *schema.ResourceData, meta interface{}) (
 
 	log.Printf("[DEBUG] Importing project card with card ID: %d", cardID)
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 	card, _, err := client.Projects.GetProjectCard(ctx, cardID)
 	if card == nil || err != nil {
 		return []*schema.ResourceData{d}, err
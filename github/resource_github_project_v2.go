@@ -0,0 +1,191 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubProjectV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubProjectV2Create,
+		Read:   resourceGithubProjectV2Read,
+		Update: resourceGithubProjectV2Update,
+		Delete: resourceGithubProjectV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The title of the project.",
+			},
+			"short_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A short description of the project.",
+			},
+			"readme": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The readme of the project, in Markdown.",
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the project is visible to everyone.",
+			},
+			"closed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the project is closed.",
+			},
+			"number": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the project within its owner.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the project.",
+			},
+		},
+	}
+}
+
+func resourceGithubProjectV2Create(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+
+	ownerID, err := getProjectV2OwnerID(ctx, meta)
+	if err != nil {
+		return err
+	}
+
+	var mutate struct {
+		CreateProjectV2 struct {
+			ProjectV2 struct {
+				ID githubv4.ID
+			}
+		} `graphql:"createProjectV2(input:$input)"`
+	}
+	input := githubv4.CreateProjectV2Input{
+		OwnerID: ownerID,
+		Title:   githubv4.String(d.Get("title").(string)),
+	}
+	err = client.Mutate(ctx, &mutate, input, nil)
+	if err != nil {
+		return fmt.Errorf("error creating project %s: %s", d.Get("title").(string), err)
+	}
+
+	d.SetId(fmt.Sprintf("%s", mutate.CreateProjectV2.ProjectV2.ID))
+
+	// The description, readme, visibility and state can only be set on an
+	// existing project
+	return resourceGithubProjectV2Update(d, meta)
+}
+
+func resourceGithubProjectV2Read(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+
+	var query struct {
+		Node struct {
+			ProjectV2 struct {
+				ID               githubv4.ID
+				Number           githubv4.Int
+				Title            githubv4.String
+				ShortDescription githubv4.String
+				Readme           githubv4.String
+				Public           githubv4.Boolean
+				Closed           githubv4.Boolean
+				URL              githubv4.URI
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id:$id)"`
+	}
+	variables := map[string]interface{}{
+		"id": githubv4.ID(d.Id()),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	if err != nil {
+		if strings.Contains(err.Error(), "Could not resolve to a node with the global id") {
+			log.Printf("[INFO] Removing project %s from state because it no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	project := query.Node.ProjectV2
+	if project.ID == nil {
+		return fmt.Errorf("%s is not the node ID of a project", d.Id())
+	}
+
+	d.Set("number", int(project.Number))
+	d.Set("title", string(project.Title))
+	d.Set("short_description", string(project.ShortDescription))
+	d.Set("readme", string(project.Readme))
+	d.Set("public", bool(project.Public))
+	d.Set("closed", bool(project.Closed))
+	d.Set("url", project.URL.String())
+
+	return nil
+}
+
+func resourceGithubProjectV2Update(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+
+	var mutate struct {
+		UpdateProjectV2 struct {
+			ProjectV2 struct {
+				ID githubv4.ID
+			}
+		} `graphql:"updateProjectV2(input:$input)"`
+	}
+	input := githubv4.UpdateProjectV2Input{
+		ProjectID:        githubv4.ID(d.Id()),
+		Title:            githubv4.NewString(githubv4.String(d.Get("title").(string))),
+		ShortDescription: githubv4.NewString(githubv4.String(d.Get("short_description").(string))),
+		Readme:           githubv4.NewString(githubv4.String(d.Get("readme").(string))),
+		Public:           githubv4.NewBoolean(githubv4.Boolean(d.Get("public").(bool))),
+		Closed:           githubv4.NewBoolean(githubv4.Boolean(d.Get("closed").(bool))),
+	}
+	err := client.Mutate(ctx, &mutate, input, nil)
+	if err != nil {
+		return fmt.Errorf("error updating project %s: %s", d.Id(), err)
+	}
+
+	return resourceGithubProjectV2Read(d, meta)
+}
+
+func resourceGithubProjectV2Delete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+
+	var mutate struct {
+		DeleteProjectV2 struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"deleteProjectV2(input:$input)"`
+	}
+	input := DeleteProjectV2Input{
+		ProjectID: githubv4.ID(d.Id()),
+	}
+	return client.Mutate(ctx, &mutate, input, nil)
+}
@@ -0,0 +1,324 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubProjectV2Field() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubProjectV2FieldCreate,
+		Read:   resourceGithubProjectV2FieldRead,
+		Update: resourceGithubProjectV2FieldUpdate,
+		Delete: resourceGithubProjectV2FieldDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceGithubProjectV2FieldDiff,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The node ID of the project.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the field.",
+			},
+			"data_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(projectV2FieldDataTypes, false),
+				Description:  "The type of the field: `TEXT`, `NUMBER`, `DATE`, `SINGLE_SELECT` or `ITERATION`.",
+			},
+			"option": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The options of a `SINGLE_SELECT` field.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the option.",
+						},
+						"color": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "GRAY",
+							ValidateFunc: validation.StringInSlice(projectV2OptionColors, false),
+							Description:  "The color of the option.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "The description of the option.",
+						},
+					},
+				},
+			},
+			"iteration_configuration": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The iterations of an `ITERATION` field.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_date": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date formatted as YYYY-MM-DD"),
+							Description:  "The date the first iteration starts, formatted as `YYYY-MM-DD`.",
+						},
+						"duration": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The duration of iterations, in days.",
+						},
+					},
+				},
+			},
+			"option_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the options of a `SINGLE_SELECT` field, by option name.",
+			},
+			"iterations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The current and upcoming iterations of an `ITERATION` field.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGithubProjectV2FieldDiff(d *schema.ResourceDiff, meta interface{}) error {
+	dataType := d.Get("data_type").(string)
+	options := d.Get("option").([]interface{})
+	iterationConfiguration := d.Get("iteration_configuration").([]interface{})
+
+	if dataType == "SINGLE_SELECT" && len(options) == 0 {
+		return fmt.Errorf("a SINGLE_SELECT field needs at least one option")
+	}
+	if dataType != "SINGLE_SELECT" && len(options) > 0 {
+		return fmt.Errorf("only SINGLE_SELECT fields have options")
+	}
+	if dataType == "ITERATION" && len(iterationConfiguration) == 0 {
+		return fmt.Errorf("an ITERATION field needs an iteration_configuration")
+	}
+	if dataType != "ITERATION" && len(iterationConfiguration) > 0 {
+		return fmt.Errorf("only ITERATION fields have an iteration_configuration")
+	}
+
+	// Options and iterations get new IDs when they are replaced
+	if d.Id() != "" && d.HasChange("option") {
+		if err := d.SetNewComputed("option_ids"); err != nil {
+			return err
+		}
+	}
+	if d.Id() != "" && d.HasChange("iteration_configuration") {
+		if err := d.SetNewComputed("iterations"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func expandProjectV2FieldOptions(d *schema.ResourceData) []ProjectV2SingleSelectFieldOptionInput {
+	var options []ProjectV2SingleSelectFieldOptionInput
+	for _, v := range d.Get("option").([]interface{}) {
+		option := v.(map[string]interface{})
+		options = append(options, ProjectV2SingleSelectFieldOptionInput{
+			Name:        option["name"].(string),
+			Color:       option["color"].(string),
+			Description: option["description"].(string),
+		})
+	}
+	return options
+}
+
+func expandProjectV2IterationConfiguration(d *schema.ResourceData) *ProjectV2IterationFieldConfigurationInput {
+	v := d.Get("iteration_configuration").([]interface{})
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	configuration := v[0].(map[string]interface{})
+	return &ProjectV2IterationFieldConfigurationInput{
+		StartDate:  configuration["start_date"].(string),
+		Duration:   configuration["duration"].(int),
+		Iterations: []interface{}{},
+	}
+}
+
+func resourceGithubProjectV2FieldCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+
+	var mutate struct {
+		CreateProjectV2Field struct {
+			ProjectV2Field projectV2FieldConfiguration `graphql:"projectV2Field"`
+		} `graphql:"createProjectV2Field(input:$input)"`
+	}
+	input := CreateProjectV2FieldInput{
+		ProjectID:              githubv4.ID(d.Get("project_id").(string)),
+		DataType:               d.Get("data_type").(string),
+		Name:                   d.Get("name").(string),
+		SingleSelectOptions:    expandProjectV2FieldOptions(d),
+		IterationConfiguration: expandProjectV2IterationConfiguration(d),
+	}
+	err := client.Mutate(ctx, &mutate, input, nil)
+	if err != nil {
+		return fmt.Errorf("error creating field %s of project %s: %s", input.Name, d.Get("project_id").(string), err)
+	}
+
+	field := mutate.CreateProjectV2Field.ProjectV2Field
+	d.SetId(fmt.Sprintf("%s", field.common().ID))
+
+	return resourceGithubProjectV2FieldRead(d, meta)
+}
+
+func resourceGithubProjectV2FieldRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+
+	var query struct {
+		Node projectV2FieldConfiguration `graphql:"node(id:$id)"`
+	}
+	variables := map[string]interface{}{
+		"id": githubv4.ID(d.Id()),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	if err != nil {
+		if strings.Contains(err.Error(), "Could not resolve to a node with the global id") {
+			log.Printf("[INFO] Removing project field %s from state because it no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	field := query.Node
+	common := field.common()
+	if common.ID == nil {
+		return fmt.Errorf("%s is not the node ID of a project field", d.Id())
+	}
+
+	d.Set("project_id", fmt.Sprintf("%s", common.Project.ID))
+	d.Set("name", string(common.Name))
+	d.Set("data_type", string(common.DataType))
+
+	optionIDs := map[string]interface{}{}
+	var options []interface{}
+	for _, v := range field.flattenOptions() {
+		option := v.(map[string]interface{})
+		optionIDs[option["name"].(string)] = option["id"]
+		delete(option, "id")
+		options = append(options, option)
+	}
+	d.Set("option", options)
+	d.Set("option_ids", optionIDs)
+
+	iterations := field.flattenIterations()
+	d.Set("iterations", iterations)
+	if field.Typename == "ProjectV2IterationField" {
+		// GitHub moves the start of the configuration along with the
+		// iterations, so the configured start date is kept
+		startDate := ""
+		if v := d.Get("iteration_configuration").([]interface{}); len(v) > 0 && v[0] != nil {
+			startDate = v[0].(map[string]interface{})["start_date"].(string)
+		} else if len(iterations) > 0 {
+			startDate = iterations[0].(map[string]interface{})["start_date"].(string)
+		}
+		d.Set("iteration_configuration", []interface{}{
+			map[string]interface{}{
+				"start_date": startDate,
+				"duration":   int(field.IterationField.Configuration.Duration),
+			},
+		})
+	}
+
+	return nil
+}
+
+func resourceGithubProjectV2FieldUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+
+	var mutate struct {
+		UpdateProjectV2Field struct {
+			ProjectV2Field projectV2FieldConfiguration `graphql:"projectV2Field"`
+		} `graphql:"updateProjectV2Field(input:$input)"`
+	}
+	input := UpdateProjectV2FieldInput{
+		FieldID: githubv4.ID(d.Id()),
+		Name:    d.Get("name").(string),
+	}
+	if d.HasChange("option") {
+		input.SingleSelectOptions = expandProjectV2FieldOptions(d)
+	}
+	if d.HasChange("iteration_configuration") {
+		input.IterationConfiguration = expandProjectV2IterationConfiguration(d)
+	}
+	err := client.Mutate(ctx, &mutate, input, nil)
+	if err != nil {
+		return fmt.Errorf("error updating project field %s: %s", d.Id(), err)
+	}
+
+	return resourceGithubProjectV2FieldRead(d, meta)
+}
+
+func resourceGithubProjectV2FieldDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+
+	var mutate struct {
+		DeleteProjectV2Field struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"deleteProjectV2Field(input:$input)"`
+	}
+	input := DeleteProjectV2FieldInput{
+		FieldID: githubv4.ID(d.Id()),
+	}
+	return client.Mutate(ctx, &mutate, input, nil)
+}
@@ -0,0 +1,103 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGithubProjectV2Field(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates and updates project fields without error", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_project_v2" "test" {
+				title = "tf-acc-test-%s"
+			}
+
+			resource "github_project_v2_field" "text" {
+				project_id = github_project_v2.test.id
+				name       = "Notes"
+				data_type  = "TEXT"
+			}
+
+			resource "github_project_v2_field" "single_select" {
+				project_id = github_project_v2.test.id
+				name       = "Priority"
+				data_type  = "SINGLE_SELECT"
+
+				option {
+					name  = "High"
+					color = "RED"
+				}
+
+				option {
+					name = "%%s"
+				}
+			}
+
+			resource "github_project_v2_field" "iteration" {
+				project_id = github_project_v2.test.id
+				name       = "Sprint"
+				data_type  = "ITERATION"
+
+				iteration_configuration {
+					start_date = "2030-01-07"
+					duration   = %%d
+				}
+			}
+		`, randomID)
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_project_v2_field.single_select", "option_ids.%", "2"),
+				resource.TestCheckResourceAttrSet("github_project_v2_field.single_select", "option_ids.Low"),
+				resource.TestCheckResourceAttrSet("github_project_v2_field.iteration", "iterations.0.id"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttrSet("github_project_v2_field.single_select", "option_ids.Medium"),
+				resource.TestCheckResourceAttr("github_project_v2_field.iteration", "iteration_configuration.0.duration", "7"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, "Low", 14),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, "Medium", 7),
+						Check:  checks["after"],
+					},
+					{
+						Config:            fmt.Sprintf(config, "Medium", 7),
+						ResourceName:      "github_project_v2_field.single_select",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
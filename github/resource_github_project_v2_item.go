@@ -0,0 +1,525 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubProjectV2Item() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubProjectV2ItemCreate,
+		Read:   resourceGithubProjectV2ItemRead,
+		Update: resourceGithubProjectV2ItemUpdate,
+		Delete: resourceGithubProjectV2ItemDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubProjectV2ItemImport,
+		},
+		CustomizeDiff: resourceGithubProjectV2ItemDiff,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The node ID of the project.",
+			},
+			"content_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"content_id", "draft_issue"},
+				Description:  "The node ID of the issue or pull request to add to the project. The node ID of the draft issue for draft issues.",
+			},
+			"draft_issue": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"content_id", "draft_issue"},
+				Description:  "A draft issue to add to the project.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The title of the draft issue.",
+						},
+						"body": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The body of the draft issue.",
+						},
+					},
+				},
+			},
+			"field_value": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The values of the fields of the item. The values of other fields are left alone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The node ID of the field.",
+						},
+						"text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The value of a `TEXT` field.",
+						},
+						"number": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "The value of a `NUMBER` field.",
+						},
+						"date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date formatted as YYYY-MM-DD"),
+							Description:  "The value of a `DATE` field, formatted as `YYYY-MM-DD`.",
+						},
+						"single_select_option_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the option of a `SINGLE_SELECT` field.",
+						},
+						"iteration_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the iteration of an `ITERATION` field.",
+						},
+					},
+				},
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the item: `ISSUE`, `PULL_REQUEST` or `DRAFT_ISSUE`.",
+			},
+		},
+	}
+}
+
+// projectV2ItemFieldValueField identifies the field of a value
+type projectV2ItemFieldValueField struct {
+	Field struct {
+		ID       githubv4.ID
+		DataType githubv4.String
+	} `graphql:"... on ProjectV2Field"`
+	SingleSelectField struct {
+		ID githubv4.ID
+	} `graphql:"... on ProjectV2SingleSelectField"`
+	IterationField struct {
+		ID githubv4.ID
+	} `graphql:"... on ProjectV2IterationField"`
+}
+
+// projectV2ItemFieldValue queries the value of a field of an item, whose type
+// tells which of its fragments holds it
+type projectV2ItemFieldValue struct {
+	Typename githubv4.String `graphql:"__typename"`
+	Text     struct {
+		Text  githubv4.String
+		Field projectV2ItemFieldValueField
+	} `graphql:"... on ProjectV2ItemFieldTextValue"`
+	Number struct {
+		Number githubv4.Float
+		Field  projectV2ItemFieldValueField
+	} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+	Date struct {
+		Date  githubv4.String
+		Field projectV2ItemFieldValueField
+	} `graphql:"... on ProjectV2ItemFieldDateValue"`
+	SingleSelect struct {
+		OptionID githubv4.String
+		Field    projectV2ItemFieldValueField
+	} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+	Iteration struct {
+		IterationID githubv4.String
+		Field       projectV2ItemFieldValueField
+	} `graphql:"... on ProjectV2ItemFieldIterationValue"`
+}
+
+// flatten returns the value as a field_value, or nil for values of types and
+// built-in fields the resource does not manage
+func (v *projectV2ItemFieldValue) flatten() map[string]interface{} {
+	value := map[string]interface{}{
+		"text":                    "",
+		"number":                  0.0,
+		"date":                    "",
+		"single_select_option_id": "",
+		"iteration_id":            "",
+	}
+
+	var field projectV2ItemFieldValueField
+	switch v.Typename {
+	case "ProjectV2ItemFieldTextValue":
+		field = v.Text.Field
+		if field.Field.DataType == "TITLE" {
+			// The title of the item
+			return nil
+		}
+		value["text"] = string(v.Text.Text)
+	case "ProjectV2ItemFieldNumberValue":
+		field = v.Number.Field
+		value["number"] = float64(v.Number.Number)
+	case "ProjectV2ItemFieldDateValue":
+		field = v.Date.Field
+		value["date"] = string(v.Date.Date)
+	case "ProjectV2ItemFieldSingleSelectValue":
+		field = v.SingleSelect.Field
+		value["single_select_option_id"] = string(v.SingleSelect.OptionID)
+	case "ProjectV2ItemFieldIterationValue":
+		field = v.Iteration.Field
+		value["iteration_id"] = string(v.Iteration.IterationID)
+	default:
+		return nil
+	}
+
+	// Every fragment of the field holds its ID
+	value["field_id"] = fmt.Sprintf("%s", field.Field.ID)
+	return value
+}
+
+// expandProjectV2ItemFieldValue returns a field_value as the value of a field,
+// which is a number unless another value is set
+func expandProjectV2ItemFieldValue(v map[string]interface{}) (projectV2FieldValue, error) {
+	var value projectV2FieldValue
+	set := 0
+	for key, target := range map[string]**string{
+		"text":                    &value.Text,
+		"date":                    &value.Date,
+		"single_select_option_id": &value.SingleSelectOptionID,
+		"iteration_id":            &value.IterationID,
+	} {
+		if s := v[key].(string); s != "" {
+			*target = &s
+			set++
+		}
+	}
+
+	switch set {
+	case 0:
+		number := v["number"].(float64)
+		value.Number = &number
+	case 1:
+		if v["number"].(float64) != 0 {
+			return value, fmt.Errorf("the value of field %s is set more than once", v["field_id"])
+		}
+	default:
+		return value, fmt.Errorf("the value of field %s is set more than once", v["field_id"])
+	}
+	return value, nil
+}
+
+func resourceGithubProjectV2ItemDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// A draft issue can be edited in place, but an item cannot become or stop
+	// being one
+	if d.Id() != "" && d.HasChange("draft_issue") {
+		old, new := d.GetChange("draft_issue")
+		if len(old.([]interface{})) != len(new.([]interface{})) {
+			return d.ForceNew("draft_issue")
+		}
+	}
+	return nil
+}
+
+func resourceGithubProjectV2ItemCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+	projectID := githubv4.ID(d.Get("project_id").(string))
+
+	if v, ok := d.GetOk("draft_issue"); ok {
+		draftIssue := v.([]interface{})[0].(map[string]interface{})
+
+		var mutate struct {
+			AddProjectV2DraftIssue struct {
+				ProjectItem struct {
+					ID githubv4.ID
+				}
+			} `graphql:"addProjectV2DraftIssue(input:$input)"`
+		}
+		input := githubv4.AddProjectV2DraftIssueInput{
+			ProjectID: projectID,
+			Title:     githubv4.String(draftIssue["title"].(string)),
+			Body:      githubv4.NewString(githubv4.String(draftIssue["body"].(string))),
+		}
+		err := client.Mutate(ctx, &mutate, input, nil)
+		if err != nil {
+			return fmt.Errorf("error adding a draft issue to project %s: %s", projectID, err)
+		}
+		d.SetId(fmt.Sprintf("%s", mutate.AddProjectV2DraftIssue.ProjectItem.ID))
+	} else {
+		var mutate struct {
+			AddProjectV2ItemByID struct {
+				Item struct {
+					ID githubv4.ID
+				}
+			} `graphql:"addProjectV2ItemById(input:$input)"`
+		}
+		input := githubv4.AddProjectV2ItemByIdInput{
+			ProjectID: projectID,
+			ContentID: githubv4.ID(d.Get("content_id").(string)),
+		}
+		err := client.Mutate(ctx, &mutate, input, nil)
+		if err != nil {
+			return fmt.Errorf("error adding %s to project %s: %s", d.Get("content_id").(string), projectID, err)
+		}
+		d.SetId(fmt.Sprintf("%s", mutate.AddProjectV2ItemByID.Item.ID))
+	}
+
+	// Every configured value is new
+	none := schema.NewSet(d.Get("field_value").(*schema.Set).F, nil)
+	if err := updateProjectV2ItemFieldValues(ctx, d, meta, none); err != nil {
+		return err
+	}
+
+	return resourceGithubProjectV2ItemRead(d, meta)
+}
+
+func resourceGithubProjectV2ItemRead(d *schema.ResourceData, meta interface{}) error {
+	// Only the values of the fields in the configuration are managed, so that
+	// values set by GitHub, e.g. by project workflows, are not drift
+	managed := make(map[string]bool)
+	for _, v := range d.Get("field_value").(*schema.Set).List() {
+		managed[v.(map[string]interface{})["field_id"].(string)] = true
+	}
+	return readProjectV2Item(d, meta, managed)
+}
+
+func resourceGithubProjectV2ItemImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+
+	// Without a configuration, the values of every field are imported
+	err := readProjectV2Item(d, meta, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// readProjectV2Item calls d.SetId("") if the item does not exist
+	if d.Id() == "" {
+		return nil, fmt.Errorf("project item %s does not exist", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// readProjectV2Item reads an item into d, keeping the values of the managed
+// fields only, or of every field when managed is nil
+func readProjectV2Item(d *schema.ResourceData, meta interface{}, managed map[string]bool) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+
+	var query struct {
+		Node struct {
+			ProjectV2Item struct {
+				ID      githubv4.ID
+				Type    githubv4.String
+				Project struct {
+					ID githubv4.ID
+				}
+				Content struct {
+					Typename   githubv4.String `graphql:"__typename"`
+					DraftIssue struct {
+						ID    githubv4.ID
+						Title githubv4.String
+						Body  githubv4.String
+					} `graphql:"... on DraftIssue"`
+					Issue struct {
+						ID githubv4.ID
+					} `graphql:"... on Issue"`
+					PullRequest struct {
+						ID githubv4.ID
+					} `graphql:"... on PullRequest"`
+				}
+				FieldValues struct {
+					Nodes []projectV2ItemFieldValue
+				} `graphql:"fieldValues(first:100)"`
+			} `graphql:"... on ProjectV2Item"`
+		} `graphql:"node(id:$id)"`
+	}
+	variables := map[string]interface{}{
+		"id": githubv4.ID(d.Id()),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	if err != nil {
+		if strings.Contains(err.Error(), "Could not resolve to a node with the global id") {
+			log.Printf("[INFO] Removing project item %s from state because it no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	item := query.Node.ProjectV2Item
+	if item.ID == nil {
+		return fmt.Errorf("%s is not the node ID of a project item", d.Id())
+	}
+
+	d.Set("project_id", fmt.Sprintf("%s", item.Project.ID))
+	d.Set("type", string(item.Type))
+
+	switch item.Content.Typename {
+	case "DraftIssue":
+		d.Set("content_id", fmt.Sprintf("%s", item.Content.DraftIssue.ID))
+		d.Set("draft_issue", []interface{}{
+			map[string]interface{}{
+				"title": string(item.Content.DraftIssue.Title),
+				"body":  string(item.Content.DraftIssue.Body),
+			},
+		})
+	case "Issue":
+		d.Set("content_id", fmt.Sprintf("%s", item.Content.Issue.ID))
+		d.Set("draft_issue", []interface{}{})
+	case "PullRequest":
+		d.Set("content_id", fmt.Sprintf("%s", item.Content.PullRequest.ID))
+		d.Set("draft_issue", []interface{}{})
+	}
+
+	var values []interface{}
+	for _, v := range item.FieldValues.Nodes {
+		value := v.flatten()
+		if value == nil || (managed != nil && !managed[value["field_id"].(string)]) {
+			continue
+		}
+		values = append(values, value)
+	}
+	d.Set("field_value", values)
+
+	return nil
+}
+
+func resourceGithubProjectV2ItemUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+
+	// resourceGithubProjectV2ItemDiff replaces items gaining or losing a draft
+	if d.HasChange("draft_issue") {
+		draftIssue := d.Get("draft_issue").([]interface{})[0].(map[string]interface{})
+
+		var mutate struct {
+			UpdateProjectV2DraftIssue struct {
+				DraftIssue struct {
+					ID githubv4.ID
+				}
+			} `graphql:"updateProjectV2DraftIssue(input:$input)"`
+		}
+		input := githubv4.UpdateProjectV2DraftIssueInput{
+			DraftIssueID: githubv4.ID(d.Get("content_id").(string)),
+			Title:        githubv4.NewString(githubv4.String(draftIssue["title"].(string))),
+			Body:         githubv4.NewString(githubv4.String(draftIssue["body"].(string))),
+		}
+		err := client.Mutate(ctx, &mutate, input, nil)
+		if err != nil {
+			return fmt.Errorf("error updating the draft issue of project item %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("field_value") {
+		old, _ := d.GetChange("field_value")
+		if err := updateProjectV2ItemFieldValues(ctx, d, meta, old.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubProjectV2ItemRead(d, meta)
+}
+
+// updateProjectV2ItemFieldValues sets the configured field values of an item
+// and clears the values of the fields among old which are no longer set
+func updateProjectV2ItemFieldValues(ctx context.Context, d *schema.ResourceData, meta interface{}, old *schema.Set) error {
+	client := meta.(*Owner).v4client
+	projectID := githubv4.ID(d.Get("project_id").(string))
+	itemID := githubv4.ID(d.Id())
+
+	current := d.Get("field_value").(*schema.Set)
+	set := make(map[string]bool)
+	for _, v := range current.List() {
+		fieldValue := v.(map[string]interface{})
+		fieldID := fieldValue["field_id"].(string)
+		set[fieldID] = true
+
+		if old.Contains(v) {
+			continue
+		}
+
+		value, err := expandProjectV2ItemFieldValue(fieldValue)
+		if err != nil {
+			return err
+		}
+
+		var mutate struct {
+			UpdateProjectV2ItemFieldValue struct {
+				ProjectV2Item struct {
+					ID githubv4.ID
+				} `graphql:"projectV2Item"`
+			} `graphql:"updateProjectV2ItemFieldValue(input:$input)"`
+		}
+		input := UpdateProjectV2ItemFieldValueInput{
+			ProjectID: projectID,
+			ItemID:    itemID,
+			FieldID:   githubv4.ID(fieldID),
+			Value:     value,
+		}
+		if err := client.Mutate(ctx, &mutate, input, nil); err != nil {
+			return fmt.Errorf("error setting field %s of project item %s: %s", fieldID, d.Id(), err)
+		}
+	}
+
+	for _, v := range old.List() {
+		fieldID := v.(map[string]interface{})["field_id"].(string)
+		if set[fieldID] {
+			continue
+		}
+
+		var mutate struct {
+			ClearProjectV2ItemFieldValue struct {
+				ProjectV2Item struct {
+					ID githubv4.ID
+				} `graphql:"projectV2Item"`
+			} `graphql:"clearProjectV2ItemFieldValue(input:$input)"`
+		}
+		input := githubv4.ClearProjectV2ItemFieldValueInput{
+			ProjectID: projectID,
+			ItemID:    itemID,
+			FieldID:   githubv4.ID(fieldID),
+		}
+		if err := client.Mutate(ctx, &mutate, input, nil); err != nil {
+			return fmt.Errorf("error clearing field %s of project item %s: %s", fieldID, d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubProjectV2ItemDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
+	client := meta.(*Owner).v4client
+
+	var mutate struct {
+		DeleteProjectV2Item struct {
+			DeletedItemID githubv4.ID `graphql:"deletedItemId"`
+		} `graphql:"deleteProjectV2Item(input:$input)"`
+	}
+	input := githubv4.DeleteProjectV2ItemInput{
+		ProjectID: githubv4.ID(d.Get("project_id").(string)),
+		ItemID:    githubv4.ID(d.Id()),
+	}
+	return client.Mutate(ctx, &mutate, input, nil)
+}
@@ -0,0 +1,123 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGithubProjectV2Item(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("adds issues and draft issues to a project without error", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name       = "tf-acc-test-%[1]s"
+				has_issues = true
+			}
+
+			resource "github_issue" "test" {
+				repository = github_repository.test.name
+				title      = "tf-acc-test-%[1]s"
+			}
+
+			resource "github_project_v2" "test" {
+				title = "tf-acc-test-%[1]s"
+			}
+
+			resource "github_project_v2_field" "estimate" {
+				project_id = github_project_v2.test.id
+				name       = "Estimate"
+				data_type  = "NUMBER"
+			}
+
+			resource "github_project_v2_item" "issue" {
+				project_id = github_project_v2.test.id
+
+				%%s
+
+				field_value {
+					field_id = github_project_v2_field.estimate.id
+					number   = %%d
+				}
+			}
+
+			resource "github_project_v2_item" "draft" {
+				project_id = github_project_v2.test.id
+
+				draft_issue {
+					title = "%%s"
+					body  = "Drafted by Terraform"
+				}
+			}
+		`, randomID)
+
+		issue := `content_id = github_issue.test.node_id`
+		draft := `
+			draft_issue {
+				title = "no longer an issue"
+			}
+		`
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_project_v2_item.issue", "type", "ISSUE"),
+				resource.TestCheckResourceAttr("github_project_v2_item.issue", "field_value.#", "1"),
+				resource.TestCheckResourceAttr("github_project_v2_item.draft", "type", "DRAFT_ISSUE"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_project_v2_item.draft", "draft_issue.0.title", "renamed"),
+			),
+			"replaced": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_project_v2_item.issue", "type", "DRAFT_ISSUE"),
+				resource.TestCheckResourceAttr("github_project_v2_item.issue", "field_value.#", "1"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, issue, 3, "draft"),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, issue, 5, "renamed"),
+						Check:  checks["after"],
+					},
+					{
+						Config:            fmt.Sprintf(config, issue, 5, "renamed"),
+						ResourceName:      "github_project_v2_item.issue",
+						ImportState:       true,
+						ImportStateVerify: true,
+						// The values of every field are imported
+						ImportStateVerifyIgnore: []string{"field_value"},
+					},
+					{
+						Config: fmt.Sprintf(config, draft, 5, "renamed"),
+						Check:  checks["replaced"],
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
@@ -0,0 +1,130 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/shurcooL/githubv4"
+)
+
+func init() {
+	resource.AddTestSweepers("github_project_v2", &resource.Sweeper{
+		Name: "github_project_v2",
+		F:    testSweepProjectsV2,
+	})
+}
+
+func testSweepProjectsV2(region string) error {
+	owner, err := testSweepOrganization(region)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	var query struct {
+		Organization struct {
+			ProjectsV2 struct {
+				Nodes []struct {
+					ID    githubv4.ID
+					Title githubv4.String
+				}
+			} `graphql:"projectsV2(first:100)"`
+		} `graphql:"organization(login:$login)"`
+	}
+	variables := map[string]interface{}{
+		"login": githubv4.String(owner.name),
+	}
+	if err := owner.v4client.Query(ctx, &query, variables); err != nil {
+		return err
+	}
+
+	var errs []string
+	for _, p := range query.Organization.ProjectsV2.Nodes {
+		if !strings.HasPrefix(string(p.Title), testSweepPrefix) {
+			continue
+		}
+		id := p.ID
+		if err := testSweep("project", string(p.Title), func() (*github.Response, error) {
+			var mutate struct {
+				DeleteProjectV2 struct {
+					ClientMutationID githubv4.String `graphql:"clientMutationId"`
+				} `graphql:"deleteProjectV2(input:$input)"`
+			}
+			return nil, owner.v4client.Mutate(ctx, &mutate, DeleteProjectV2Input{ProjectID: id}, nil)
+		}); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	return testSweepErrors(errs)
+}
+
+func TestAccGithubProjectV2(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates and updates a project without error", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_project_v2" "test" {
+				title             = "tf-acc-test-%s"
+				short_description = "%%s"
+				readme            = "Managed by Terraform"
+				closed            = %%t
+			}
+		`, randomID)
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttrSet("github_project_v2.test", "number"),
+				resource.TestCheckResourceAttrSet("github_project_v2.test", "url"),
+				resource.TestCheckResourceAttr("github_project_v2.test", "closed", "false"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_project_v2.test", "short_description", "updated"),
+				resource.TestCheckResourceAttr("github_project_v2.test", "closed", "true"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, "created", false),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, "updated", true),
+						Check:  checks["after"],
+					},
+					{
+						Config:            fmt.Sprintf(config, "updated", true),
+						ResourceName:      "github_project_v2.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
@@ -86,7 +86,9 @@ func resourceGithubRelease() *schema.Resource {
 }
 
 func resourceGithubReleaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.Background()
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxId, d.Id())
 	}
@@ -148,8 +150,11 @@ func resourceGithubReleaseCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubReleaseRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	repository := d.Get("repository").(string)
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	releaseID, err := strconv.ParseInt(d.Id(), 10, 64)
@@ -169,7 +174,9 @@ func resourceGithubReleaseRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGithubReleaseDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	repository := d.Get("repository").(string)
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
@@ -192,6 +199,8 @@ func resourceGithubReleaseDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceGithubReleaseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx := stopContext(meta)
+
 	repoName, releaseIDStr, err := parseTwoPartID(d.Id(), "repository", "release")
 	if err != nil {
 		return []*schema.ResourceData{d}, err
@@ -208,7 +217,6 @@ func resourceGithubReleaseImport(d *schema.ResourceData, meta interface{}) ([]*s
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 	repository, _, err := client.Repositories.Get(ctx, owner, repoName)
 	if repository == nil || err != nil {
 		return []*schema.ResourceData{d}, err
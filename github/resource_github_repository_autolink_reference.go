@@ -39,7 +39,7 @@ func resourceGithubRepositoryAutolinkReference() *schema.Resource {
 					client := meta.(*Owner).v3client
 					owner := meta.(*Owner).name
 
-					autolink, err := getAutolinkByKeyPrefix(client, owner, repository, id)
+					autolink, err := getAutolinkByKeyPrefix(stopContext(meta), client, owner, repository, id)
 					if err != nil {
 						return nil, err
 					}
@@ -90,6 +90,9 @@ func resourceGithubRepositoryAutolinkReference() *schema.Resource {
 }
 
 func resourceGithubRepositoryAutolinkReferenceCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -97,7 +100,6 @@ func resourceGithubRepositoryAutolinkReferenceCreate(d *schema.ResourceData, met
 	keyPrefix := d.Get("key_prefix").(string)
 	targetURLTemplate := d.Get("target_url_template").(string)
 	isAlphanumeric := d.Get("is_alphanumeric").(bool)
-	ctx := context.Background()
 
 	opts := &github.AutolinkOptions{
 		KeyPrefix:      &keyPrefix,
@@ -115,6 +117,9 @@ func resourceGithubRepositoryAutolinkReferenceCreate(d *schema.ResourceData, met
 }
 
 func resourceGithubRepositoryAutolinkReferenceRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -123,7 +128,7 @@ func resourceGithubRepositoryAutolinkReferenceRead(d *schema.ResourceData, meta
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -152,6 +157,9 @@ func resourceGithubRepositoryAutolinkReferenceRead(d *schema.ResourceData, meta
 }
 
 func resourceGithubRepositoryAutolinkReferenceDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -160,7 +168,7 @@ func resourceGithubRepositoryAutolinkReferenceDelete(d *schema.ResourceData, met
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	_, err = client.Repositories.DeleteAutolink(ctx, owner, repoName, autolinkRefID)
 	return err
@@ -267,12 +267,12 @@ func listAllCollaborators(client *github.Client, isOrg bool, ctx context.Context
 }
 
 func matchUserCollaboratorsAndInvites(
+	ctx context.Context,
 	repoName string, want []interface{}, hasUsers []userCollaborator, hasInvites []invitedCollaborator,
 	meta interface{}) error {
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	for _, has := range hasUsers {
 		var wantPermission string
@@ -365,17 +365,17 @@ func matchUserCollaboratorsAndInvites(
 }
 
 func matchTeamCollaborators(
+	ctx context.Context,
 	repoName string, want []interface{}, has []teamCollaborator, meta interface{}) error {
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	for _, hasTeam := range has {
 		var wantPerm string
 		for _, w := range want {
 			teamData := w.(map[string]interface{})
 			teamIDString := teamData["team_id"].(string)
-			teamSlug, err := getTeamSlug(teamIDString, meta)
+			teamSlug, err := getTeamSlug(ctx, teamIDString, meta)
 			if err != nil {
 				return err
 			}
@@ -406,7 +406,7 @@ func matchTeamCollaborators(
 	for _, t := range want {
 		teamData := t.(map[string]interface{})
 		teamIDString := teamData["team_id"].(string)
-		teamSlug, err := getTeamSlug(teamIDString, meta)
+		teamSlug, err := getTeamSlug(ctx, teamIDString, meta)
 		if err != nil {
 			return err
 		}
@@ -436,6 +436,9 @@ func matchTeamCollaborators(
 }
 
 func resourceGithubRepositoryCollaboratorsCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -443,7 +446,6 @@ func resourceGithubRepositoryCollaboratorsCreate(d *schema.ResourceData, meta in
 	users := d.Get("user").(*schema.Set).List()
 	teams := d.Get("team").(*schema.Set).List()
 	repoName := d.Get("repository").(string)
-	ctx := context.Background()
 
 	usersMap := make(map[string]struct{})
 	for _, user := range users {
@@ -467,12 +469,12 @@ func resourceGithubRepositoryCollaboratorsCreate(d *schema.ResourceData, meta in
 		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "repository collaborators (%s/%s)", owner, repoName)
 	}
 
-	err = matchUserCollaboratorsAndInvites(repoName, users, userCollaborators, invitations, meta)
+	err = matchUserCollaboratorsAndInvites(ctx, repoName, users, userCollaborators, invitations, meta)
 	if err != nil {
 		return err
 	}
 
-	err = matchTeamCollaborators(repoName, teams, teamCollaborators, meta)
+	err = matchTeamCollaborators(ctx, repoName, teams, teamCollaborators, meta)
 	if err != nil {
 		return err
 	}
@@ -483,12 +485,15 @@ func resourceGithubRepositoryCollaboratorsCreate(d *schema.ResourceData, meta in
 }
 
 func resourceGithubRepositoryCollaboratorsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
 	isOrg := meta.(*Owner).IsOrganization
 	repoName := d.Id()
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	userCollaborators, invitedCollaborators, teamCollaborators, err := listAllCollaborators(client, isOrg, ctx, owner, repoName)
 	if err != nil {
@@ -525,12 +530,14 @@ func resourceGithubRepositoryCollaboratorsUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceGithubRepositoryCollaboratorsDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
 	isOrg := meta.(*Owner).IsOrganization
 	repoName := d.Get("repository").(string)
-	ctx := context.Background()
 
 	userCollaborators, invitations, teamCollaborators, err := listAllCollaborators(client, isOrg, ctx, owner, repoName)
 	if err != nil {
@@ -540,12 +547,12 @@ func resourceGithubRepositoryCollaboratorsDelete(d *schema.ResourceData, meta in
 	log.Printf("[DEBUG] Deleting all users, invites and collaborators for repo: %s.", repoName)
 
 	// delete all users
-	err = matchUserCollaboratorsAndInvites(repoName, nil, userCollaborators, invitations, meta)
+	err = matchUserCollaboratorsAndInvites(ctx, repoName, nil, userCollaborators, invitations, meta)
 	if err != nil {
 		return err
 	}
 
 	// delete all teams
-	err = matchTeamCollaborators(repoName, nil, teamCollaborators, meta)
+	err = matchTeamCollaborators(ctx, repoName, nil, teamCollaborators, meta)
 	return err
 }
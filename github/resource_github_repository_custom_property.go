@@ -0,0 +1,170 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceGithubRepositoryCustomProperty() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryCustomPropertyCreateOrUpdate,
+		Read:   resourceGithubRepositoryCustomPropertyRead,
+		Update: resourceGithubRepositoryCustomPropertyCreateOrUpdate,
+		Delete: resourceGithubRepositoryCustomPropertyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubRepositoryCustomPropertyImport,
+		},
+		CustomizeDiff: requireGHESVersionDiff("3.13", "github_repository_custom_property"),
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"property_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the custom property.",
+			},
+			"property_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(customPropertyValueTypes, false),
+				Description:  "The type of the values of the custom property: `string`, `single_select`, `multi_select` or `true_false`.",
+			},
+			"property_value": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The value of the custom property for the repository. Only `multi_select` properties take more than one value.",
+			},
+		},
+	}
+}
+
+// setRepositoryCustomProperty sets the value of a custom property of a
+// repository, unsetting it when value is null
+func setRepositoryCustomProperty(ctx context.Context, meta interface{}, repoName, name string, value json.RawMessage) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	body := map[string]interface{}{
+		"properties": []*customPropertyValue{
+			{PropertyName: name, Value: value},
+		},
+	}
+	_, err := customPropertyRequest(ctx, client, "PATCH", repositoryCustomPropertyValuesPath(owner, repoName), body, nil)
+
+	// The values the github_repository data source cached are stale now
+	meta.(*Owner).customProperties.forget(owner)
+
+	return err
+}
+
+func resourceGithubRepositoryCustomPropertyCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
+	repoName := d.Get("repository").(string)
+	name := d.Get("property_name").(string)
+
+	value, err := encodeCustomPropertyValue(d.Get("property_type").(string), expandStringList(d.Get("property_value").(*schema.Set).List()))
+	if err != nil {
+		return fmt.Errorf("invalid property_value of custom property %s: %s", name, err)
+	}
+
+	if err := setRepositoryCustomProperty(ctx, meta, repoName, name, value); err != nil {
+		return fmt.Errorf("error setting custom property %s of repository %s: %s", name, repoName, err)
+	}
+
+	d.SetId(buildTwoPartID(repoName, name))
+
+	return resourceGithubRepositoryCustomPropertyRead(d, meta)
+}
+
+func resourceGithubRepositoryCustomPropertyRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName, name, err := parseTwoPartID(d.Id(), "repository", "property_name")
+	if err != nil {
+		return err
+	}
+
+	ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	values, err := getRepositoryCustomPropertyValues(ctx, client, owner, repoName)
+	if err != nil {
+		return deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "repository %s", repoName)
+	}
+
+	var value []string
+	for _, v := range values {
+		if v.PropertyName != name {
+			continue
+		}
+		value, err = decodeCustomPropertyValue(v.Value)
+		if err != nil {
+			return fmt.Errorf("unable to decode the value of custom property %s: %s", d.Id(), err)
+		}
+	}
+
+	if len(value) == 0 {
+		log.Printf("[INFO] Removing custom property %s from state because it is no longer set in GitHub",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("repository", repoName)
+	d.Set("property_name", name)
+	d.Set("property_value", value)
+
+	return nil
+}
+
+func resourceGithubRepositoryCustomPropertyDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
+	repoName, name, err := parseTwoPartID(d.Id(), "repository", "property_name")
+	if err != nil {
+		return err
+	}
+
+	ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	return setRepositoryCustomProperty(ctx, meta, repoName, name, json.RawMessage("null"))
+}
+
+// resourceGithubRepositoryCustomPropertyImport looks up the type of the
+// custom property, which the value of a repository does not tell
+func resourceGithubRepositoryCustomPropertyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	_, name, err := parseTwoPartID(d.Id(), "repository", "property_name")
+	if err != nil {
+		return nil, err
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	var property customProperty
+	_, err = customPropertyRequest(stopContext(meta), client, "GET", customPropertySchemaPath(owner, name), nil, &property)
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up custom property %s: %s", name, err)
+	}
+	d.Set("property_type", property.ValueType)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,94 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGithubRepositoryCustomProperty(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("sets the custom property values of a repository", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_organization_custom_property" "test" {
+				property_name  = "tf-acc-test-%[1]s"
+				value_type     = "multi_select"
+				allowed_values = ["pii", "pci", "phi"]
+			}
+
+			resource "github_repository" "test" {
+				name = "tf-acc-test-%[1]s"
+			}
+
+			resource "github_repository_custom_property" "test" {
+				repository     = github_repository.test.name
+				property_name  = github_organization_custom_property.test.property_name
+				property_type  = "multi_select"
+				property_value = [%%s]
+			}
+		`, randomID)
+
+		config2 := config + `
+			data "github_repository" "test" {
+				name = github_repository.test.name
+			}
+		`
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_custom_property.test", "property_value.#", "1"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_custom_property.test", "property_value.#", "2"),
+			),
+			"data": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.github_repository.test", fmt.Sprintf("custom_properties.tf-acc-test-%s", randomID), "pii,pci"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, `"pii"`),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, `"pii", "pci"`),
+						Check:  checks["after"],
+					},
+					{
+						Config: fmt.Sprintf(config2, `"pii", "pci"`),
+						Check:  checks["data"],
+					},
+					{
+						Config:            fmt.Sprintf(config, `"pii", "pci"`),
+						ResourceName:      "github_repository_custom_property.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			t.Skip("individual account not supported for this operation")
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
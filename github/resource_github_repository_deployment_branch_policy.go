@@ -46,7 +46,9 @@ func resourceGithubRepositoryDeploymentBranchPolicy() *schema.Resource {
 }
 
 func resourceGithubRepositoryDeploymentBranchPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.Background()
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
@@ -67,7 +69,9 @@ func resourceGithubRepositoryDeploymentBranchPolicyUpdate(d *schema.ResourceData
 }
 
 func resourceGithubRepositoryDeploymentBranchPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.Background()
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxId, d.Id())
 	}
@@ -89,7 +93,9 @@ func resourceGithubRepositoryDeploymentBranchPolicyCreate(d *schema.ResourceData
 }
 
 func resourceGithubRepositoryDeploymentBranchPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -121,7 +127,9 @@ func resourceGithubRepositoryDeploymentBranchPolicyRead(d *schema.ResourceData,
 }
 
 func resourceGithubRepositoryDeploymentBranchPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
@@ -86,6 +86,9 @@ func resourceGithubRepositoryEnvironment() *schema.Resource {
 }
 
 func resourceGithubRepositoryEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -94,8 +97,6 @@ func resourceGithubRepositoryEnvironmentCreate(d *schema.ResourceData, meta inte
 	escapedEnvName := url.QueryEscape(envName)
 	updateData := createUpdateEnvironmentData(d, meta)
 
-	ctx := context.Background()
-
 	_, _, err := client.Repositories.CreateUpdateEnvironment(ctx, owner, repoName, escapedEnvName, &updateData)
 
 	if err != nil {
@@ -108,6 +109,9 @@ func resourceGithubRepositoryEnvironmentCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceGithubRepositoryEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -117,7 +121,7 @@ func resourceGithubRepositoryEnvironmentRead(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	env, _, err := client.Repositories.GetEnvironment(ctx, owner, repoName, escapedEnvName)
 	if err != nil {
@@ -177,6 +181,9 @@ func resourceGithubRepositoryEnvironmentRead(d *schema.ResourceData, meta interf
 }
 
 func resourceGithubRepositoryEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -185,8 +192,6 @@ func resourceGithubRepositoryEnvironmentUpdate(d *schema.ResourceData, meta inte
 	escapedEnvName := url.QueryEscape(envName)
 	updateData := createUpdateEnvironmentData(d, meta)
 
-	ctx := context.Background()
-
 	resultKey, _, err := client.Repositories.CreateUpdateEnvironment(ctx, owner, repoName, escapedEnvName, &updateData)
 	if err != nil {
 		return err
@@ -198,6 +203,9 @@ func resourceGithubRepositoryEnvironmentUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceGithubRepositoryEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -207,7 +215,7 @@ func resourceGithubRepositoryEnvironmentDelete(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	_, err = client.Repositories.DeleteEnvironment(ctx, owner, repoName, escapedEnvName)
 	return err
@@ -30,7 +30,7 @@ func resourceGithubRepositoryFile() *schema.Resource {
 				owner := meta.(*Owner).name
 				repo, file := splitRepoFilePath(parts[0])
 				// test if a file exists in a repository.
-				ctx := context.WithValue(context.Background(), ctxId, fmt.Sprintf("%s/%s", repo, file))
+				ctx := context.WithValue(stopContext(meta), ctxId, fmt.Sprintf("%s/%s", repo, file))
 				opts := &github.RepositoryContentGetOptions{}
 				if len(parts) == 2 {
 					opts.Ref = parts[1]
@@ -160,10 +160,11 @@ func resourceGithubRepositoryFileOptions(d *schema.ResourceData) (*github.Reposi
 }
 
 func resourceGithubRepositoryFileCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repo := d.Get("repository").(string)
 	file := d.Get("file").(string)
@@ -172,7 +173,7 @@ func resourceGithubRepositoryFileCreate(d *schema.ResourceData, meta interface{}
 
 	if branch, ok := d.GetOk("branch"); ok {
 		log.Printf("[DEBUG] Using explicitly set branch: %s", branch.(string))
-		if err := checkRepositoryBranchExists(client, owner, repo, branch.(string)); err != nil {
+		if err := checkRepositoryBranchExists(ctx, client, owner, repo, branch.(string)); err != nil {
 			return err
 		}
 		checkOpt.Ref = branch.(string)
@@ -226,10 +227,12 @@ func resourceGithubRepositoryFileCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGithubRepositoryFileRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	repo, file := splitRepoFilePath(d.Id())
 
@@ -237,7 +240,7 @@ func resourceGithubRepositoryFileRead(d *schema.ResourceData, meta interface{})
 
 	if branch, ok := d.GetOk("branch"); ok {
 		log.Printf("[DEBUG] Using explicitly set branch: %s", branch.(string))
-		if err := checkRepositoryBranchExists(client, owner, repo, branch.(string)); err != nil {
+		if err := checkRepositoryBranchExists(ctx, client, owner, repo, branch.(string)); err != nil {
 			return err
 		}
 		opts.Ref = branch.(string)
@@ -280,7 +283,7 @@ func resourceGithubRepositoryFileRead(d *schema.ResourceData, meta interface{})
 		commit, _, err = client.Repositories.GetCommit(ctx, owner, repo, sha.(string), nil)
 	} else {
 		log.Printf("[DEBUG] Commit SHA unknown for file: %s/%s/%s, looking for commit...", owner, repo, file)
-		commit, err = getFileCommit(client, owner, repo, file, ref)
+		commit, err = getFileCommit(ctx, client, owner, repo, file, ref)
 		log.Printf("[DEBUG] Found file: %s/%s/%s, in commit SHA: %s ", owner, repo, file, commit.GetSHA())
 	}
 	if err != nil {
@@ -306,17 +309,18 @@ func resourceGithubRepositoryFileRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubRepositoryFileUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repo := d.Get("repository").(string)
 	file := d.Get("file").(string)
 
 	if branch, ok := d.GetOk("branch"); ok {
 		log.Printf("[DEBUG] Using explicitly set branch: %s", branch.(string))
-		if err := checkRepositoryBranchExists(client, owner, repo, branch.(string)); err != nil {
+		if err := checkRepositoryBranchExists(ctx, client, owner, repo, branch.(string)); err != nil {
 			return err
 		}
 	}
@@ -342,10 +346,11 @@ func resourceGithubRepositoryFileUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGithubRepositoryFileDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
-	ctx := context.Background()
 
 	repo := d.Get("repository").(string)
 	file := d.Get("file").(string)
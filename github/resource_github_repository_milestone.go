@@ -88,8 +88,10 @@ const (
 )
 
 func resourceGithubRepositoryMilestoneCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	conn := meta.(*Owner).v3client
-	ctx := context.Background()
 	owner := d.Get("owner").(string)
 	repoName := d.Get("repository").(string)
 
@@ -125,8 +127,11 @@ func resourceGithubRepositoryMilestoneCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceGithubRepositoryMilestoneRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	conn := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	owner := d.Get("owner").(string)
 	repoName := d.Get("repository").(string)
@@ -163,8 +168,11 @@ func resourceGithubRepositoryMilestoneRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceGithubRepositoryMilestoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	conn := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	owner := d.Get("owner").(string)
 	repoName := d.Get("repository").(string)
 	number, err := parseMilestoneNumber(d.Id())
@@ -209,8 +217,11 @@ func resourceGithubRepositoryMilestoneUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceGithubRepositoryMilestoneDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	conn := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	owner := d.Get("owner").(string)
 	repoName := d.Get("repository").(string)
 	number, err := parseMilestoneNumber(d.Id())
@@ -61,6 +61,9 @@ func resourceGithubRepositoryProject() *schema.Resource {
 }
 
 func resourceGithubRepositoryProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner := meta.(*Owner).name
@@ -72,7 +75,6 @@ func resourceGithubRepositoryProjectCreate(d *schema.ResourceData, meta interfac
 		Name: &name,
 		Body: &body,
 	}
-	ctx := context.Background()
 
 	project, _, err := client.Repositories.CreateProject(ctx,
 		owner, repoName, &options)
@@ -85,6 +87,9 @@ func resourceGithubRepositoryProjectCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceGithubRepositoryProjectRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
 
@@ -92,7 +97,7 @@ func resourceGithubRepositoryProjectRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -123,6 +128,9 @@ func resourceGithubRepositoryProjectRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceGithubRepositoryProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	name := d.Get("name").(string)
@@ -137,7 +145,7 @@ func resourceGithubRepositoryProjectUpdate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	_, _, err = client.Projects.UpdateProject(ctx, projectID, &options)
 	if err != nil {
@@ -148,13 +156,16 @@ func resourceGithubRepositoryProjectUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceGithubRepositoryProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	projectID, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	_, err = client.Projects.DeleteProject(ctx, projectID)
 	return err
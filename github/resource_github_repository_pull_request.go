@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -134,7 +133,9 @@ func resourceGithubRepositoryPullRequest() *schema.Resource {
 }
 
 func resourceGithubRepositoryPullRequestCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.TODO()
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	// For convenience, by default we expect that the base repository and head
@@ -174,7 +175,9 @@ func resourceGithubRepositoryPullRequestCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceGithubRepositoryPullRequestRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.TODO()
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner, repository, number, err := parsePullRequestID(d)
@@ -232,7 +235,9 @@ func resourceGithubRepositoryPullRequestRead(d *schema.ResourceData, meta interf
 }
 
 func resourceGithubRepositoryPullRequestUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.TODO()
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner, repository, number, err := parsePullRequestID(d)
@@ -276,7 +281,9 @@ func resourceGithubRepositoryPullRequestDelete(d *schema.ResourceData, meta inte
 		return nil
 	}
 
-	ctx := context.TODO()
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	owner, repository, number, err := parsePullRequestID(d)
@@ -0,0 +1,228 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceGithubRepositoryRuleset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryRulesetCreate,
+		Read:   resourceGithubRepositoryRulesetRead,
+		Update: resourceGithubRepositoryRulesetUpdate,
+		Delete: resourceGithubRepositoryRulesetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubRepositoryRulesetImport,
+		},
+		CustomizeDiff: requireGHESVersionDiff("3.11", "github_repository_ruleset"),
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository of the ruleset.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the ruleset.",
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"branch", "tag"}, false),
+				Description:  "The refs the ruleset applies to, either `branch` or `tag`.",
+			},
+			"enforcement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"disabled", "active", "evaluate"}, false),
+				Description:  "The enforcement of the ruleset: `disabled`, `active`, or `evaluate` to only report violations.",
+			},
+			"bypass_actors": rulesetBypassActorsSchema(),
+			"conditions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The conditions under which the ruleset applies.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ref_name": rulesetRefNameSchema(),
+					},
+				},
+			},
+			"rules": rulesetRulesSchema(),
+			"ruleset_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the ruleset.",
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The GraphQL node ID of the ruleset.",
+			},
+		},
+	}
+}
+
+func expandRepositoryRuleset(d *schema.ResourceData) *ruleset {
+	rs := &ruleset{
+		Name:         d.Get("name").(string),
+		Target:       d.Get("target").(string),
+		Enforcement:  d.Get("enforcement").(string),
+		BypassActors: expandRulesetBypassActors(d.Get("bypass_actors")),
+		Rules:        expandRulesetRules(d.Get("rules")),
+	}
+
+	if v, ok := d.Get("conditions").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		conditions := v[0].(map[string]interface{})
+		rs.Conditions = &rulesetConditions{
+			RefName: expandRulesetRefName(conditions["ref_name"]),
+		}
+	}
+
+	return rs
+}
+
+func resourceGithubRepositoryRulesetPath(owner, repoName, rulesetID string) string {
+	path := fmt.Sprintf("repos/%s/%s/rulesets", owner, repoName)
+	if rulesetID != "" {
+		path += "/" + rulesetID
+	}
+	return path
+}
+
+func resourceGithubRepositoryRulesetCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	rs, _, err := rulesetRequest(ctx, client, "POST", resourceGithubRepositoryRulesetPath(owner, repoName, ""), expandRepositoryRuleset(d))
+	if err != nil {
+		return fmt.Errorf("error creating ruleset %s in repository %s/%s: %s", d.Get("name").(string), owner, repoName, err)
+	}
+
+	d.SetId(buildTwoPartID(repoName, strconv.FormatInt(rs.ID, 10)))
+
+	return resourceGithubRepositoryRulesetRead(d, meta)
+}
+
+func resourceGithubRepositoryRulesetRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+
+	owner := meta.(*Owner).name
+	repoName, rulesetID, err := parseTwoPartID(d.Id(), "repository", "ruleset_id")
+	if err != nil {
+		return err
+	}
+
+	ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	rs, _, err := rulesetRequest(ctx, client, "GET", resourceGithubRepositoryRulesetPath(owner, repoName, rulesetID), nil)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing ruleset %s from state because it no longer exists in GitHub",
+					d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	rules, err := flattenRulesetRules(rs.Rules)
+	if err != nil {
+		return fmt.Errorf("error reading the rules of ruleset %s: %s", d.Id(), err)
+	}
+
+	d.Set("repository", repoName)
+	d.Set("ruleset_id", int(rs.ID))
+	d.Set("node_id", rs.NodeID)
+	d.Set("name", rs.Name)
+	d.Set("target", rs.Target)
+	d.Set("enforcement", rs.Enforcement)
+	d.Set("bypass_actors", flattenRulesetBypassActors(rs.BypassActors))
+	d.Set("rules", rules)
+
+	if rs.Conditions != nil && rs.Conditions.RefName != nil {
+		d.Set("conditions", []interface{}{
+			map[string]interface{}{
+				"ref_name": flattenRulesetRefName(rs.Conditions.RefName),
+			},
+		})
+	} else {
+		d.Set("conditions", []interface{}{})
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryRulesetUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+
+	owner := meta.(*Owner).name
+	repoName, rulesetID, err := parseTwoPartID(d.Id(), "repository", "ruleset_id")
+	if err != nil {
+		return err
+	}
+
+	ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	_, _, err = rulesetRequest(ctx, client, "PUT", resourceGithubRepositoryRulesetPath(owner, repoName, rulesetID), expandRepositoryRuleset(d))
+	if err != nil {
+		return fmt.Errorf("error updating ruleset %s: %s", d.Id(), err)
+	}
+
+	return resourceGithubRepositoryRulesetRead(d, meta)
+}
+
+func resourceGithubRepositoryRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
+	client := meta.(*Owner).v3client
+
+	owner := meta.(*Owner).name
+	repoName, rulesetID, err := parseTwoPartID(d.Id(), "repository", "ruleset_id")
+	if err != nil {
+		return err
+	}
+
+	ctx = context.WithValue(ctx, ctxId, d.Id())
+
+	_, _, err = rulesetRequest(ctx, client, "DELETE", resourceGithubRepositoryRulesetPath(owner, repoName, rulesetID), nil)
+	return err
+}
+
+func resourceGithubRepositoryRulesetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	repoName, rulesetID, err := parseTwoPartID(d.Id(), "repository", "ruleset_id")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := strconv.ParseInt(rulesetID, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid ruleset ID %q: %s", rulesetID, err)
+	}
+
+	d.Set("repository", repoName)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,128 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGithubRepositoryRuleset(t *testing.T) {
+
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	t.Run("creates and updates a repository ruleset", func(t *testing.T) {
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "tf-acc-test-%s"
+				auto_init = true
+			}
+
+			resource "github_repository_environment" "test" {
+				repository  = github_repository.test.name
+				environment = "test"
+			}
+
+			resource "github_repository_ruleset" "test" {
+				repository  = github_repository.test.name
+				name        = "test"
+				target      = "branch"
+				enforcement = "%%s"
+
+				bypass_actors {
+					actor_id    = 5
+					actor_type  = "RepositoryRole"
+					bypass_mode = "always"
+				}
+
+				conditions {
+					ref_name {
+						include = ["~DEFAULT_BRANCH"]
+						exclude = []
+					}
+				}
+
+				rules {
+					creation                = true
+					update                  = true
+					deletion                = true
+					required_linear_history = true
+					required_signatures     = false
+					non_fast_forward        = true
+
+					required_deployments {
+						required_deployment_environments = [github_repository_environment.test.environment]
+					}
+
+					pull_request {
+						required_approving_review_count   = 1
+						required_review_thread_resolution = true
+					}
+
+					required_status_checks {
+						required_check {
+							context = "ci"
+						}
+						strict_required_status_checks_policy = true
+					}
+
+					commit_message_pattern {
+						name     = "ticket"
+						operator = "regex"
+						pattern  = "^[A-Z]+-[0-9]+"
+					}
+				}
+			}
+		`, randomID)
+
+		checks := map[string]resource.TestCheckFunc{
+			"before": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_ruleset.test", "enforcement", "evaluate"),
+				resource.TestCheckResourceAttr("github_repository_ruleset.test", "rules.0.non_fast_forward", "true"),
+				resource.TestCheckResourceAttr("github_repository_ruleset.test", "rules.0.required_deployments.0.required_deployment_environments.0", "test"),
+				resource.TestCheckResourceAttrSet("github_repository_ruleset.test", "node_id"),
+			),
+			"after": resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("github_repository_ruleset.test", "enforcement", "active"),
+			),
+		}
+
+		testCase := func(t *testing.T, mode string) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:  func() { skipUnlessMode(t, mode) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(config, "evaluate"),
+						Check:  checks["before"],
+					},
+					{
+						Config: fmt.Sprintf(config, "active"),
+						Check:  checks["after"],
+					},
+					{
+						Config:            fmt.Sprintf(config, "active"),
+						ResourceName:      "github_repository_ruleset.test",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		}
+
+		t.Run("with an anonymous account", func(t *testing.T) {
+			t.Skip("anonymous account not supported for this operation")
+		})
+
+		t.Run("with an individual account", func(t *testing.T) {
+			testCase(t, individual)
+		})
+
+		t.Run("with an organization account", func(t *testing.T) {
+			testCase(t, organization)
+		})
+
+	})
+}
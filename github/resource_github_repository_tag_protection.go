@@ -53,8 +53,10 @@ func resourceGithubRepositoryTagProtection() *schema.Resource {
 }
 
 func resourceGithubRepositoryTagProtectionCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
-	ctx := context.Background()
 	owner := meta.(*Owner).name
 	repo := d.Get("repository").(string)
 	pattern := d.Get("pattern").(string)
@@ -69,8 +71,9 @@ func resourceGithubRepositoryTagProtectionCreateOrUpdate(d *schema.ResourceData,
 }
 
 func resourceGithubRepositoryTagProtectionRead(d *schema.ResourceData, meta interface{}) error {
-
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	client := meta.(*Owner).v3client
 	owner := meta.(*Owner).name
@@ -104,8 +107,11 @@ func resourceGithubRepositoryTagProtectionRead(d *schema.ResourceData, meta inte
 }
 
 func resourceGithubRepositoryTagProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	owner := meta.(*Owner).name
 	repo := d.Get("repository").(string)
 	tag_protection_id, err := strconv.ParseInt(d.Id(), 10, 64)
@@ -3,11 +3,11 @@ package github
 import (
 	"context"
 	"fmt"
-	"log"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
@@ -1377,35 +1377,49 @@ func TestGithubRepositoryTopicFailsValidationWhenOverMaxCharacters(t *testing.T)
 }
 
 func testSweepRepositories(region string) error {
-	meta, err := sharedConfigForRegion(region)
+	owner, err := testSweepOrganization(region)
 	if err != nil {
 		return err
 	}
+	client := owner.v3client
+	ctx := context.Background()
+
+	var errs []string
+	opts := &github.RepositoryListByOrgOptions{ListOptions: github.ListOptions{PerPage: maxPerPage}}
+	for {
+		repos, resp, err := client.Repositories.ListByOrg(ctx, owner.name, opts)
+		if err != nil {
+			return err
+		}
 
-	client := meta.(*Owner).v3client
-
-	repos, _, err := client.Repositories.List(context.TODO(), meta.(*Owner).name, nil)
-	if err != nil {
-		return err
-	}
-
-	for _, r := range repos {
-		if name := r.GetName(); strings.HasPrefix(name, "tf-acc-") || strings.HasPrefix(name, "foo-") {
-			log.Printf("[DEBUG] Destroying Repository %s", name)
-
-			if _, err := client.Repositories.Delete(context.TODO(), meta.(*Owner).name, name); err != nil {
-				return err
+		for _, repo := range repos {
+			name := repo.GetName()
+			if !strings.HasPrefix(name, testSweepPrefix) && !strings.HasPrefix(name, "foo-") {
+				continue
+			}
+			if err := testSweep("repository", name, func() (*github.Response, error) {
+				return client.Repositories.Delete(ctx, owner.name, name)
+			}); err != nil {
+				errs = append(errs, err.Error())
 			}
 		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
 	}
 
-	return nil
+	return testSweepErrors(errs)
 }
 
 func init() {
+	// Teams and runner groups are granted access to repositories, so they go
+	// first
 	resource.AddTestSweepers("github_repository", &resource.Sweeper{
-		Name: "github_repository",
-		F:    testSweepRepositories,
+		Name:         "github_repository",
+		F:            testSweepRepositories,
+		Dependencies: []string{"github_team", "github_actions_runner_group"},
 	})
 }
 
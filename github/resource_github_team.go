@@ -103,6 +103,9 @@ func resourceGithubTeam() *schema.Resource {
 }
 
 func resourceGithubTeamCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -124,13 +127,12 @@ func resourceGithubTeamCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if parentTeamID, ok := d.GetOk("parent_team_id"); ok {
-		teamId, err := getTeamID(parentTeamID.(string), meta)
+		teamId, err := getTeamID(ctx, parentTeamID.(string), meta)
 		if err != nil {
 			return err
 		}
 		newTeam.ParentTeamID = &teamId
 	}
-	ctx := context.Background()
 
 	githubTeam, _, err := client.Teams.CreateTeam(ctx,
 		ownerName, newTeam)
@@ -166,7 +168,7 @@ func resourceGithubTeamCreate(d *schema.ResourceData, meta interface{}) error {
 	create_default_maintainer := d.Get("create_default_maintainer").(bool)
 	if !create_default_maintainer {
 		log.Printf("[DEBUG] Removing default maintainer from team: %s (%s)", name, ownerName)
-		if err := removeDefaultMaintainer(*githubTeam.Slug, meta); err != nil {
+		if err := removeDefaultMaintainer(ctx, *githubTeam.Slug, meta); err != nil {
 			return err
 		}
 	}
@@ -176,6 +178,9 @@ func resourceGithubTeamCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -188,7 +193,7 @@ func resourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -229,6 +234,9 @@ func resourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGithubTeamUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -243,7 +251,7 @@ func resourceGithubTeamUpdate(d *schema.ResourceData, meta interface{}) error {
 		Privacy:     github.String(d.Get("privacy").(string)),
 	}
 	if parentTeamID, ok := d.GetOk("parent_team_id"); ok {
-		teamId, err := getTeamID(parentTeamID.(string), meta)
+		teamId, err := getTeamID(ctx, parentTeamID.(string), meta)
 		if err != nil {
 			return err
 		}
@@ -254,7 +262,7 @@ func resourceGithubTeamUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	team, _, err := client.Teams.EditTeamByID(ctx, orgId, teamId, editedTeam, false)
 	if err != nil {
@@ -277,6 +285,9 @@ func resourceGithubTeamUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGithubTeamDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -289,7 +300,7 @@ func resourceGithubTeamDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	_, err = client.Teams.DeleteTeamByID(ctx, orgId, id)
 	/*
@@ -319,7 +330,9 @@ func resourceGithubTeamDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGithubTeamImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	teamId, err := getTeamID(d.Id(), meta)
+	ctx := stopContext(meta)
+
+	teamId, err := getTeamID(ctx, d.Id(), meta)
 	if err != nil {
 		return nil, err
 	}
@@ -330,7 +343,7 @@ func resourceGithubTeamImport(d *schema.ResourceData, meta interface{}) ([]*sche
 	return []*schema.ResourceData{d}, nil
 }
 
-func removeDefaultMaintainer(teamSlug string, meta interface{}) error {
+func removeDefaultMaintainer(ctx context.Context, teamSlug string, meta interface{}) error {
 
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
@@ -354,13 +367,13 @@ func removeDefaultMaintainer(teamSlug string, meta interface{}) error {
 		"login": githubv4.String(orgName),
 	}
 
-	err := v4client.Query(meta.(*Owner).StopContext, &query, variables)
+	err := v4client.Query(ctx, &query, variables)
 	if err != nil {
 		return err
 	}
 
 	for _, user := range query.Organization.Team.Members.Nodes {
-		_, err := client.Teams.RemoveTeamMembershipBySlug(meta.(*Owner).StopContext, orgName, teamSlug, string(user.Login))
+		_, err := client.Teams.RemoveTeamMembershipBySlug(ctx, orgName, teamSlug, string(user.Login))
 		if err != nil {
 			return err
 		}
@@ -60,15 +60,17 @@ func resourceGithubTeamMembers() *schema.Resource {
 }
 
 func resourceGithubTeamMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgId := meta.(*Owner).id
 
 	teamIdString := d.Get("team_id").(string)
-	teamId, err := getTeamID(teamIdString, meta)
+	teamId, err := getTeamID(ctx, teamIdString, meta)
 	if err != nil {
 		return err
 	}
-	ctx := context.Background()
 
 	members := d.Get("members").(*schema.Set)
 	for _, mMap := range members.List() {
@@ -96,15 +98,17 @@ func resourceGithubTeamMembersCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGithubTeamMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgId := meta.(*Owner).id
 
 	teamIdString := d.Get("team_id").(string)
-	teamId, err := getTeamID(teamIdString, meta)
+	teamId, err := getTeamID(ctx, teamIdString, meta)
 	if err != nil {
 		return err
 	}
-	ctx := context.Background()
 
 	o, n := d.GetChange("members")
 	vals := make(map[string]*MemberChange)
@@ -174,6 +178,9 @@ func resourceGithubTeamMembersUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceGithubTeamMembersRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v4client
 	orgName := meta.(*Owner).name
 	teamIdString := d.Get("team_id").(string)
@@ -182,7 +189,7 @@ func resourceGithubTeamMembersRead(d *schema.ResourceData, meta interface{}) err
 		teamIdString = d.Id()
 	}
 
-	teamSlug, err := getTeamSlug(teamIdString, meta)
+	teamSlug, err := getTeamSlug(ctx, teamIdString, meta)
 	if err != nil {
 		return err
 	}
@@ -192,7 +199,7 @@ func resourceGithubTeamMembersRead(d *schema.ResourceData, meta interface{}) err
 	// See https://github.com/integrations/terraform-provider-github/issues/323
 	d.Set("team_id", teamIdString)
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	log.Printf("[DEBUG] Reading team members: %s", teamIdString)
 	var q struct {
@@ -236,16 +243,19 @@ func resourceGithubTeamMembersRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceGithubTeamMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgId := meta.(*Owner).id
 	teamIdString := d.Get("team_id").(string)
-	teamId, err := getTeamID(teamIdString, meta)
+	teamId, err := getTeamID(ctx, teamIdString, meta)
 	if err != nil {
 		return err
 	}
 
 	members := d.Get("members").(*schema.Set)
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	for _, member := range members.List() {
 		mem := member.(map[string]interface{})
@@ -19,12 +19,14 @@ func resourceGithubTeamMembership() *schema.Resource {
 		Delete: resourceGithubTeamMembershipDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				ctx := stopContext(meta)
+
 				teamIdString, username, err := parseTwoPartID(d.Id(), "team_id", "username")
 				if err != nil {
 					return nil, err
 				}
 
-				teamId, err := getTeamID(teamIdString, meta)
+				teamId, err := getTeamID(ctx, teamIdString, meta)
 				if err != nil {
 					return nil, err
 				}
@@ -64,15 +66,17 @@ func resourceGithubTeamMembership() *schema.Resource {
 }
 
 func resourceGithubTeamMembershipCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, createOrUpdateOperation(d))
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgId := meta.(*Owner).id
 
 	teamIdString := d.Get("team_id").(string)
-	teamId, err := getTeamID(teamIdString, meta)
+	teamId, err := getTeamID(ctx, teamIdString, meta)
 	if err != nil {
 		return err
 	}
-	ctx := context.Background()
 
 	username := d.Get("username").(string)
 	role := d.Get("role").(string)
@@ -95,6 +99,9 @@ func resourceGithubTeamMembershipCreateOrUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceGithubTeamMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgId := meta.(*Owner).id
 	teamIdString, username, err := parseTwoPartID(d.Id(), "team_id", "username")
@@ -102,7 +109,7 @@ func resourceGithubTeamMembershipRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	teamId, err := getTeamID(teamIdString, meta)
+	teamId, err := getTeamID(ctx, teamIdString, meta)
 	if err != nil {
 		return err
 	}
@@ -113,7 +120,7 @@ func resourceGithubTeamMembershipRead(d *schema.ResourceData, meta interface{})
 	d.Set("team_id", teamIdString)
 	d.Set("username", username)
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -142,15 +149,18 @@ func resourceGithubTeamMembershipRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubTeamMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 	orgId := meta.(*Owner).id
 	teamIdString := d.Get("team_id").(string)
-	teamId, err := getTeamID(teamIdString, meta)
+	teamId, err := getTeamID(ctx, teamIdString, meta)
 	if err != nil {
 		return err
 	}
 	username := d.Get("username").(string)
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	_, err = client.Teams.RemoveTeamMembershipByID(ctx, orgId, teamId, username)
 
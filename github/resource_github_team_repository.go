@@ -18,12 +18,14 @@ func resourceGithubTeamRepository() *schema.Resource {
 		Delete: resourceGithubTeamRepositoryDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				ctx := stopContext(meta)
+
 				teamIdString, username, err := parseTwoPartID(d.Id(), "team_id", "username")
 				if err != nil {
 					return nil, err
 				}
 
-				teamId, err := getTeamID(teamIdString, meta)
+				teamId, err := getTeamID(ctx, teamIdString, meta)
 				if err != nil {
 					return nil, err
 				}
@@ -61,6 +63,9 @@ func resourceGithubTeamRepository() *schema.Resource {
 }
 
 func resourceGithubTeamRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -71,7 +76,7 @@ func resourceGithubTeamRepositoryCreate(d *schema.ResourceData, meta interface{}
 
 	// The given team id could be an id or a slug
 	givenTeamId := d.Get("team_id").(string)
-	teamId, err := getTeamID(givenTeamId, meta)
+	teamId, err := getTeamID(ctx, givenTeamId, meta)
 	if err != nil {
 		return err
 	}
@@ -79,7 +84,6 @@ func resourceGithubTeamRepositoryCreate(d *schema.ResourceData, meta interface{}
 	orgName := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
 	permission := d.Get("permission").(string)
-	ctx := context.Background()
 
 	_, err = client.Teams.AddTeamRepoByID(ctx,
 		orgId,
@@ -101,6 +105,9 @@ func resourceGithubTeamRepositoryCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGithubTeamRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -113,12 +120,12 @@ func resourceGithubTeamRepositoryRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	teamId, err := getTeamID(teamIdString, meta)
+	teamId, err := getTeamID(ctx, teamIdString, meta)
 	if err != nil {
 		return err
 	}
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
@@ -152,6 +159,9 @@ func resourceGithubTeamRepositoryRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubTeamRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -170,7 +180,7 @@ func resourceGithubTeamRepositoryUpdate(d *schema.ResourceData, meta interface{}
 	}
 	orgName := meta.(*Owner).name
 	permission := d.Get("permission").(string)
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	// the go-github library's AddTeamRepo method uses the add/update endpoint from GitHub API
 	_, err = client.Teams.AddTeamRepoByID(ctx,
@@ -192,6 +202,9 @@ func resourceGithubTeamRepositoryUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGithubTeamRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -209,7 +222,7 @@ func resourceGithubTeamRepositoryDelete(d *schema.ResourceData, meta interface{}
 		return unconvertibleIdErr(teamIdString, err)
 	}
 	orgName := meta.(*Owner).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 
 	resp, err := client.Teams.RemoveTeamRepoByID(ctx, orgId, teamId, orgName, repoName)
 
@@ -91,6 +91,9 @@ func resourceGithubTeamSettings() *schema.Resource {
 }
 
 func resourceGithubTeamSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -98,7 +101,6 @@ func resourceGithubTeamSettingsCreate(d *schema.ResourceData, meta interface{})
 
 	// Given a string that is either a team id or team slug, return the
 	// get the basic details of the team including node_id and slug
-	ctx := context.Background()
 
 	teamIDString, _ := d.Get("team_id").(string)
 
@@ -114,6 +116,9 @@ func resourceGithubTeamSettingsCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubTeamSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutRead)
+	defer cancel()
+
 	err := checkOrganization(meta)
 	if err != nil {
 		return err
@@ -130,7 +135,7 @@ func resourceGithubTeamSettingsRead(d *schema.ResourceData, meta interface{}) er
 		"login": githubv4.String(orgName),
 	}
 
-	e := graphql.Query(meta.(*Owner).StopContext, &query, variables)
+	e := graphql.Query(ctx, &query, variables)
 	if e != nil {
 		return e
 	}
@@ -150,9 +155,12 @@ func resourceGithubTeamSettingsRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceGithubTeamSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutUpdate)
+	defer cancel()
+
 	if d.HasChange("review_request_delegation") || d.IsNewResource() {
 
-		ctx := context.WithValue(context.Background(), ctxId, d.Id())
+		ctx := context.WithValue(ctx, ctxId, d.Id())
 		graphql := meta.(*Owner).v4client
 		if setting := d.Get("review_request_delegation").([]interface{}); len(setting) == 0 {
 			var mutation struct {
@@ -186,7 +194,9 @@ func resourceGithubTeamSettingsUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubTeamSettingsDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx, cancel := operationContext(d, meta, schema.TimeoutDelete)
+	defer cancel()
+	ctx = context.WithValue(ctx, ctxId, d.Id())
 	graphql := meta.(*Owner).v4client
 
 	var mutation struct {
@@ -200,7 +210,9 @@ func resourceGithubTeamSettingsDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceGithubTeamSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	nodeId, slug, err := resolveTeamIDs(d.Id(), meta.(*Owner), context.Background())
+	ctx := stopContext(meta)
+
+	nodeId, slug, err := resolveTeamIDs(d.Id(), meta.(*Owner), ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,9 +1,12 @@
 package github
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 )
@@ -217,3 +220,47 @@ func TestAccGithubTeamUpdateName(t *testing.T) {
 
 	})
 }
+
+func testSweepTeams(region string) error {
+	owner, err := testSweepOrganization(region)
+	if err != nil {
+		return err
+	}
+	client := owner.v3client
+	ctx := context.Background()
+
+	var errs []string
+	opts := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		teams, resp, err := client.Teams.ListTeams(ctx, owner.name, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, team := range teams {
+			slug := team.GetSlug()
+			if !strings.HasPrefix(slug, testSweepPrefix) {
+				continue
+			}
+			if err := testSweep("team", slug, func() (*github.Response, error) {
+				return client.Teams.DeleteTeamBySlug(ctx, owner.name, slug)
+			}); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return testSweepErrors(errs)
+}
+
+func init() {
+	resource.AddTestSweepers("github_team", &resource.Sweeper{
+		Name: "github_team",
+		F:    testSweepTeams,
+	})
+}
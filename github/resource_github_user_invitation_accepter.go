@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
@@ -34,6 +33,9 @@ func resourceGithubUserInvitationAccepter() *schema.Resource {
 }
 
 func resourceGithubUserInvitationAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := operationContext(d, meta, schema.TimeoutCreate)
+	defer cancel()
+
 	client := meta.(*Owner).v3client
 
 	invitationIdString := d.Get("invitation_id").(string)
@@ -54,7 +56,6 @@ func resourceGithubUserInvitationAccepterCreate(d *schema.ResourceData, meta int
 	if err != nil {
 		return fmt.Errorf("failed to parse invitation ID: %s", err)
 	}
-	ctx := context.Background()
 
 	_, err = client.Users.AcceptInvitation(ctx, int64(invitationId))
 	if err != nil {
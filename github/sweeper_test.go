@@ -1,13 +1,28 @@
 package github
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 )
 
+// testSweepPrefix starts the name of every repository, team and runner group
+// created by acceptance tests. Organization secrets use its uppercase,
+// underscored form, as GitHub uppercases secret names.
+const testSweepPrefix = "tf-acc-"
+
+// testSweepWebhookURLPrefix starts the URL of every organization webhook
+// created by acceptance tests
+const testSweepWebhookURLPrefix = "https://google.de/"
+
 func TestMain(m *testing.M) {
 	resource.TestMain(m)
 }
@@ -17,20 +32,114 @@ func sharedConfigForRegion(region string) (interface{}, error) {
 		return nil, fmt.Errorf("empty GITHUB_TOKEN")
 	}
 
-	if os.Getenv("GITHUB_OWNER") == "" {
-		return nil, fmt.Errorf("empty GITHUB_OWNER")
+	owner := testOrganizationFunc()
+	if owner == "" {
+		owner = os.Getenv("GITHUB_OWNER")
+	}
+	if owner == "" {
+		return nil, fmt.Errorf("empty GITHUB_ORGANIZATION and GITHUB_OWNER")
+	}
+
+	baseURL := os.Getenv("GITHUB_BASE_URL")
+	if baseURL == "" {
+		baseURL = "https://api.github.com/"
 	}
 
 	config := Config{
 		Token:   os.Getenv("GITHUB_TOKEN"),
-		Owner:   os.Getenv("GITHUB_OWNER"),
-		BaseURL: "",
+		Owner:   owner,
+		BaseURL: baseURL,
 	}
 
 	meta, err := config.Meta()
 	if err != nil {
-		return nil, fmt.Errorf("error getting GitHub meta parameter")
+		return nil, fmt.Errorf("error getting GitHub meta parameter: %s", err)
 	}
 
 	return meta, nil
 }
+
+// testSweepOrganization returns the provider meta for the organization to
+// sweep
+func testSweepOrganization(region string) (*Owner, error) {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := meta.(*Owner)
+	if !owner.IsOrganization {
+		return nil, fmt.Errorf("%s is not an organization", owner.name)
+	}
+	return owner, nil
+}
+
+// testSweepDryRun tells whether sweepers only list what they would delete,
+// which GITHUB_SWEEP_DRY_RUN enables
+func testSweepDryRun() bool {
+	dryRun, _ := strconv.ParseBool(os.Getenv("GITHUB_SWEEP_DRY_RUN"))
+	return dryRun
+}
+
+// testSweep deletes an object left behind by acceptance tests, or only logs
+// it in dry-run mode. Objects already gone, e.g. child teams deleted along
+// with their parent, are not an error.
+func testSweep(kind, name string, del func() (*github.Response, error)) error {
+	if testSweepDryRun() {
+		log.Printf("[INFO] Would delete %s %s", kind, name)
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting %s %s", kind, name)
+	resp, err := del()
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return fmt.Errorf("error deleting %s %s: %s", kind, name, err)
+	}
+	return nil
+}
+
+// testSweepErrors combines the errors of a sweeper, which keeps going after a
+// failed deletion
+func testSweepErrors(errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d errors while sweeping:\n%s", len(errs), strings.Join(errs, "\n"))
+}
+
+// testSweepOrganizationSecrets sweeps the organization secrets of one kind,
+// listed and deleted by the given functions
+func testSweepOrganizationSecrets(owner *Owner, kind string,
+	list func(ctx context.Context, org string, opts *github.ListOptions) (*github.Secrets, *github.Response, error),
+	del func(ctx context.Context, org, name string) (*github.Response, error)) error {
+	ctx := context.Background()
+	prefix := strings.ToUpper(strings.ReplaceAll(testSweepPrefix, "-", "_"))
+
+	var errs []string
+	opts := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		secrets, resp, err := list(ctx, owner.name, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, secret := range secrets.Secrets {
+			name := secret.Name
+			if !strings.HasPrefix(strings.ToUpper(name), prefix) {
+				continue
+			}
+			if err := testSweep(kind, name, func() (*github.Response, error) {
+				return del(ctx, owner.name, name)
+			}); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return testSweepErrors(errs)
+}
@@ -2,18 +2,28 @@ package github
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"log"
+	"math/rand"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/google/go-github/v53/github"
 )
 
 const (
-	ctxEtag = ctxEtagType("etag")
-	ctxId   = ctxIdType("id")
+	ctxEtag         = ctxEtagType("etag")
+	ctxId           = ctxIdType("id")
+	ctxResourceType = ctxResourceTypeType("resource_type")
 )
 
 // ctxIdType is used to avoid collisions between packages using context
@@ -22,10 +32,17 @@ type ctxIdType string
 // ctxEtagType is used to avoid collisions between packages using context
 type ctxEtagType string
 
+// ctxResourceTypeType is used to avoid collisions between packages using context
+type ctxResourceTypeType string
+
 // etagTransport allows saving API quota by passing previously stored Etag
-// available via context to request headers
+// available via context to request headers. When a response cache is
+// configured, other GET requests are made conditional automatically and
+// answered from the cache when nothing changed.
 type etagTransport struct {
-	transport http.RoundTripper
+	transport  http.RoundTripper
+	cache      ResponseCache
+	cacheScope string
 }
 
 func (ett *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -33,84 +50,266 @@ func (ett *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 	etag := ctx.Value(ctxEtag)
 	if v, ok := etag.(string); ok && v != "" {
+		// The resource handles 304 Not Modified itself
 		req.Header.Set("If-None-Match", v)
+		return ett.transport.RoundTrip(req)
+	}
+
+	if ett.cache != nil && req.Method == "GET" {
+		return ett.cachedRoundTrip(req)
 	}
 
 	return ett.transport.RoundTrip(req)
 }
 
-func NewEtagTransport(rt http.RoundTripper) *etagTransport {
-	return &etagTransport{transport: rt}
+type EtagTransportOption func(*etagTransport)
+
+func NewEtagTransport(rt http.RoundTripper, options ...EtagTransportOption) *etagTransport {
+	ett := &etagTransport{transport: rt}
+
+	for _, opt := range options {
+		opt(ett)
+	}
+
+	return ett
+}
+
+// WithResponseCache is used to store responses and serve them again when
+// GitHub reports that they have not been modified
+func WithResponseCache(cache ResponseCache) EtagTransportOption {
+	return func(ett *etagTransport) {
+		ett.cache = cache
+	}
+}
+
+// WithResponseCacheScope keeps the cached responses apart from those of other
+// credentials. scope identifies the credentials the transports below add to
+// requests, which the cache does not see otherwise.
+func WithResponseCacheScope(scope string) EtagTransportOption {
+	return func(ett *etagTransport) {
+		ett.cacheScope = scope
+	}
 }
 
 // RateLimitTransport implements GitHub's best practices
 // for avoiding rate limits
 // https://developer.github.com/v3/guides/best-practices-for-integrators/#dealing-with-abuse-rate-limits
 type RateLimitTransport struct {
-	transport        http.RoundTripper
-	nextRequestDelay time.Duration
-	writeDelay       time.Duration
-	readDelay        time.Duration
-	parallelRequests bool
+	transport          http.RoundTripper
+	nextRequestDelay   time.Duration
+	writeDelay         time.Duration
+	readDelay          time.Duration
+	parallelRequests   bool
+	maxConcurrentReads int
+	budgetThreshold    int
+	budgetReserve      int
+	maxRetries         int
+	maxWait            time.Duration
+
+	m          sync.RWMutex
+	readSlots  chan struct{}
+	delayMutex sync.Mutex
+
+	budgets     map[string]rateLimitBudget
+	budgetMutex sync.Mutex
+}
 
-	m sync.Mutex
+// rateLimitBudget is the state of a primary rate limit as last reported by
+// GitHub in the X-RateLimit-* response headers.
+type rateLimitBudget struct {
+	remaining int
+	reset     time.Time
 }
 
 func (rlt *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	// Make requests for a single user or client ID serially when parallel_requests is false.
-	// If parallel_requests is true skips the lock and allow the parallelism defined by terraform itself.
-	rlt.smartLock(true)
+	var waited time.Duration
+	for attempt := 0; ; attempt++ {
+		r, err := requestForAttempt(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		limit := rateLimitRetry{
+			allowed: attempt < rlt.maxRetries,
+			maxWait: rlt.maxWait - waited,
+		}
+		resp, wait, retry, err := rlt.roundTrip(r, limit)
+		if !retry {
+			return resp, err
+		}
+		waited += wait
+	}
+}
+
+// rateLimitRetry bounds how a rate limited request may still be retried
+type rateLimitRetry struct {
+	allowed bool
+	maxWait time.Duration
+}
+
+// roundTrip sends a single request, reporting whether it was rejected by a
+// rate limit and has to be sent again after the sleep it already performed,
+// and how long that sleep was. Once limit forbids another attempt, the rate
+// limited response is returned to the caller instead.
+func (rlt *RateLimitTransport) roundTrip(req *http.Request, limit rateLimitRetry) (*http.Response, time.Duration, bool, error) {
+	// Make writes for a single user or client ID serially and limit concurrent reads when
+	// parallel_requests is false. If parallel_requests is true skips the locks and allow the
+	// parallelism defined by terraform itself.
+	unlock := rlt.lockFor(req)
 
 	// Sleep for the delay that the last request defined. This delay might be different
-	// for read and write requests. See isWriteMethod for the distinction between them.
-	if rlt.nextRequestDelay > 0 {
-		log.Printf("[DEBUG] Sleeping %s between operations", rlt.nextRequestDelay)
-		time.Sleep(rlt.nextRequestDelay)
+	// for read and write requests. See isReadRequest for the distinction between them.
+	if delay := rlt.swapNextRequestDelay(rlt.calculateNextDelay(req)); delay > 0 {
+		log.Printf("[DEBUG] Sleeping %s between operations", delay)
+		if err := sleepContext(req.Context(), delay); err != nil {
+			unlock()
+			return nil, 0, false, err
+		}
 	}
 
-	rlt.nextRequestDelay = rlt.calculateNextDelay(req.Method)
+	// Spread the remaining requests over the rest of the rate limit window
+	// instead of running into the limit.
+	if budgetDelay := rlt.budgetDelay(req); budgetDelay > 0 {
+		log.Printf("[DEBUG] Rate limit budget running low, sleeping %s before the next request", budgetDelay)
+		if err := sleepContext(req.Context(), budgetDelay); err != nil {
+			unlock()
+			return nil, 0, false, err
+		}
+	}
 
 	resp, err := rlt.transport.RoundTrip(req)
 	if err != nil {
-		rlt.smartLock(false)
-		return resp, err
+		unlock()
+		return resp, 0, false, err
 	}
 
+	rlt.updateBudget(req, resp)
+
 	// Make response body accessible for retries & debugging
 	// (work around bug in GitHub SDK)
 	// See https://github.com/google/go-github/pull/986
 	r1, r2, err := drainBody(resp.Body)
 	if err != nil {
-		return nil, err
+		unlock()
+		return nil, 0, false, err
 	}
 	resp.Body = r1
 	ghErr := github.CheckResponse(resp)
 	resp.Body = r2
 
+	// GitHub tells how long to back off in the headers of a rate limited
+	// response, whatever the shape of its error body.
+	if retryAfter, ok := rateLimitedRetryAfter(resp); ok {
+		rlt.swapNextRequestDelay(0)
+		log.Printf("[DEBUG] Rate limited with status %d, sleeping for %s before retrying",
+			resp.StatusCode, retryAfter)
+		defer unlock()
+		return rlt.waitForRetry(req, resp, retryAfter, limit)
+	}
+
+	// GraphQL reports rate limits in the response body, often with a 200 status
+	if isGraphQLRequest(req) {
+		retryAfter, limited, err := rlt.graphQLRateLimited(resp)
+		if err != nil {
+			unlock()
+			return nil, 0, false, err
+		}
+		if limited {
+			rlt.swapNextRequestDelay(0)
+			log.Printf("[DEBUG] GraphQL rate limit reached, sleeping for %s (until %s) before retrying",
+				retryAfter, time.Now().Add(retryAfter))
+			defer unlock()
+			return rlt.waitForRetry(req, resp, retryAfter, limit)
+		}
+	}
+
 	// When you have been limited, use the Retry-After response header to slow down.
 	if arlErr, ok := ghErr.(*github.AbuseRateLimitError); ok {
-		rlt.nextRequestDelay = 0
+		rlt.swapNextRequestDelay(0)
 		retryAfter := arlErr.GetRetryAfter()
 		log.Printf("[DEBUG] Abuse detection mechanism triggered, sleeping for %s before retrying",
 			retryAfter)
-		time.Sleep(retryAfter)
-		rlt.smartLock(false)
-		return rlt.RoundTrip(req)
+		defer unlock()
+		return rlt.waitForRetry(req, resp, retryAfter, limit)
 	}
 
 	if rlErr, ok := ghErr.(*github.RateLimitError); ok {
-		rlt.nextRequestDelay = 0
+		rlt.swapNextRequestDelay(0)
 		retryAfter := time.Until(rlErr.Rate.Reset.Time)
 		log.Printf("[DEBUG] Rate limit %d reached, sleeping for %s (until %s) before retrying",
 			rlErr.Rate.Limit, retryAfter, time.Now().Add(retryAfter))
-		time.Sleep(retryAfter)
-		rlt.smartLock(false)
-		return rlt.RoundTrip(req)
+		defer unlock()
+		return rlt.waitForRetry(req, resp, retryAfter, limit)
 	}
 
-	rlt.smartLock(false)
+	unlock()
 
-	return resp, nil
+	return resp, 0, false, nil
+}
+
+// waitForRetry sleeps until a rate limited request may be sent again. When
+// the request is cancelled in the meantime, the rate limited response is
+// discarded and the cancellation reported instead. When the retries are used
+// up or the wait would exceed what is left of the maximum wait, the rate
+// limited response is returned without waiting.
+func (rlt *RateLimitTransport) waitForRetry(req *http.Request, resp *http.Response, retryAfter time.Duration, limit rateLimitRetry) (*http.Response, time.Duration, bool, error) {
+	if retryAfter < 0 {
+		retryAfter = 0
+	}
+
+	if !limit.allowed {
+		log.Printf("[WARN] Still rate limited after %d retries, giving up", rlt.maxRetries)
+		return resp, 0, false, nil
+	}
+	if retryAfter > limit.maxWait {
+		log.Printf("[WARN] Rate limited for %s, which exceeds the remaining wait of %s, giving up", retryAfter, limit.maxWait)
+		return resp, 0, false, nil
+	}
+
+	if err := sleepContext(req.Context(), retryAfter); err != nil {
+		_ = resp.Body.Close()
+		return nil, 0, false, err
+	}
+	return resp, retryAfter, true, nil
+}
+
+// lockFor takes the lock matching the kind of request and returns the function
+// releasing it. Writes are serialized with every other request, while up to
+// maxConcurrentReads reads may be in flight at once.
+func (rlt *RateLimitTransport) lockFor(req *http.Request) func() {
+	if isReadRequest(req) {
+		rlt.smartRLock(true)
+		return func() { rlt.smartRLock(false) }
+	}
+
+	rlt.smartLock(true)
+	return func() { rlt.smartLock(false) }
+}
+
+// smartRLock is the counterpart of smartLock for read requests. It shares the
+// mutex with other reads and takes one of the maxConcurrentReads slots.
+func (rlt *RateLimitTransport) smartRLock(lock bool) {
+	if rlt.parallelRequests {
+		return
+	}
+	if lock {
+		rlt.m.RLock()
+		rlt.readSlots <- struct{}{}
+		return
+	}
+	<-rlt.readSlots
+	rlt.m.RUnlock()
+}
+
+// swapNextRequestDelay sets the delay before the next request and returns the
+// previous one. Reads may run concurrently, so the delay is guarded by a mutex.
+func (rlt *RateLimitTransport) swapNextRequestDelay(d time.Duration) time.Duration {
+	rlt.delayMutex.Lock()
+	defer rlt.delayMutex.Unlock()
+
+	previous := rlt.nextRequestDelay
+	rlt.nextRequestDelay = d
+	return previous
 }
 
 // smartLock wraps the mutex locking system and performs its operation via a boolean input for locking and unlocking.
@@ -126,13 +325,188 @@ func (rlt *RateLimitTransport) smartLock(lock bool) {
 	rlt.m.Unlock()
 }
 
+// budgetDelay returns how long to wait before sending req so that the
+// remaining requests of its rate limit are spread evenly until the limit
+// resets. It only kicks in once fewer than budgetThreshold requests remain,
+// and waits for the reset once only budgetReserve requests are left.
+func (rlt *RateLimitTransport) budgetDelay(req *http.Request) time.Duration {
+	if rlt.budgetThreshold <= 0 {
+		return 0
+	}
+
+	rlt.budgetMutex.Lock()
+	defer rlt.budgetMutex.Unlock()
+
+	budget, ok := rlt.budgets[rateLimitResource(req)]
+	if !ok || budget.remaining > rlt.budgetThreshold {
+		return 0
+	}
+
+	window := time.Until(budget.reset)
+	if window <= 0 {
+		return 0
+	}
+
+	available := budget.remaining - rlt.budgetReserve
+	if available <= 0 {
+		return window
+	}
+
+	return window / time.Duration(available)
+}
+
+// updateBudget records the rate limit state reported in the headers of resp
+func (rlt *RateLimitTransport) updateBudget(req *http.Request, resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	resource := resp.Header.Get("X-RateLimit-Resource")
+	if resource == "" {
+		resource = rateLimitResource(req)
+	}
+
+	rlt.budgetMutex.Lock()
+	defer rlt.budgetMutex.Unlock()
+
+	if rlt.budgets == nil {
+		rlt.budgets = make(map[string]rateLimitBudget)
+	}
+	rlt.budgets[resource] = rateLimitBudget{remaining: remaining, reset: time.Unix(reset, 0)}
+}
+
+// graphQLSecondaryRateLimitDelay is how long to wait after a GraphQL secondary
+// rate limit when GitHub does not say, as recommended by its documentation.
+const graphQLSecondaryRateLimitDelay = time.Minute
+
+// graphQLResponse holds the parts of a GraphQL response body describing
+// rate limits: errors of type RATE_LIMITED and the rateLimit object which
+// queries may request.
+type graphQLResponse struct {
+	Data struct {
+		RateLimit *struct {
+			Cost      int       `json:"cost"`
+			Remaining int       `json:"remaining"`
+			ResetAt   time.Time `json:"resetAt"`
+		} `json:"rateLimit"`
+	} `json:"data"`
+	Errors []struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"errors"`
+	Message string `json:"message"`
+}
+
+// graphQLRateLimited inspects the body of a GraphQL response, recording the
+// rateLimit information it carries and reporting whether the request was
+// rejected by the primary or secondary rate limit and how long to wait.
+func (rlt *RateLimitTransport) graphQLRateLimited(resp *http.Response) (time.Duration, bool, error) {
+	body, bodyCopy, err := drainBody(resp.Body)
+	if err != nil {
+		return 0, false, err
+	}
+	resp.Body = bodyCopy
+
+	var payload graphQLResponse
+	if err := json.NewDecoder(body).Decode(&payload); err != nil {
+		// Not a GraphQL payload GitHub's rate limiting would produce
+		return 0, false, nil
+	}
+
+	reset := time.Time{}
+	if v, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		reset = time.Unix(v, 0)
+	}
+
+	if rl := payload.Data.RateLimit; rl != nil {
+		log.Printf("[DEBUG] GraphQL query cost %d, %d points remaining until %s", rl.Cost, rl.Remaining, rl.ResetAt)
+		reset = rl.ResetAt
+
+		rlt.budgetMutex.Lock()
+		if rlt.budgets == nil {
+			rlt.budgets = make(map[string]rateLimitBudget)
+		}
+		rlt.budgets["graphql"] = rateLimitBudget{remaining: rl.Remaining, reset: rl.ResetAt}
+		rlt.budgetMutex.Unlock()
+	}
+
+	for _, e := range payload.Errors {
+		if e.Type == "RATE_LIMITED" {
+			if wait := time.Until(reset); wait > 0 {
+				return wait, true, nil
+			}
+			return graphQLSecondaryRateLimitDelay, true, nil
+		}
+	}
+
+	if resp.StatusCode == http.StatusForbidden && strings.Contains(strings.ToLower(payload.Message), "secondary rate limit") {
+		return graphQLSecondaryRateLimitDelay, true, nil
+	}
+
+	return 0, false, nil
+}
+
+// rateLimitResource returns the name of the rate limit a request counts
+// against, matching the X-RateLimit-Resource header of GitHub responses.
+func rateLimitResource(req *http.Request) string {
+	switch {
+	case isGraphQLRequest(req):
+		return "graphql"
+	case strings.Contains(req.URL.Path, "/search/"):
+		return "search"
+	}
+	return "core"
+}
+
+// rateLimitedRetryAfter reports whether resp was rejected by a rate limit and
+// how long to wait before retrying, based on the Retry-After header or, when
+// the primary rate limit is exhausted, on X-RateLimit-Reset.
+func rateLimitedRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if retryAfter, ok := parseRetryAfter(resp); ok {
+		return retryAfter, true
+	}
+
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			return time.Until(time.Unix(reset, 0)), true
+		}
+	}
+
+	return 0, false
+}
+
+// parseRetryAfter returns the delay requested by the Retry-After header of
+// resp, given either in seconds, possibly fractional, or as an HTTP date.
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseFloat(v, 64); err == nil {
+		return time.Duration(seconds * float64(time.Second)), true
+	}
+	if date, err := http.ParseTime(v); err == nil {
+		return time.Until(date), true
+	}
+	return 0, false
+}
+
 // calculateNextDelay returns a time.Duration specifying the backoff before the next request
-// the actual value depends on the current method being a write or a read request
-func (rlt *RateLimitTransport) calculateNextDelay(method string) time.Duration {
-	if isWriteMethod(method) {
-		return rlt.writeDelay
+// the actual value depends on the current request being a write or a read request
+func (rlt *RateLimitTransport) calculateNextDelay(req *http.Request) time.Duration {
+	if isReadRequest(req) {
+		return rlt.readDelay
 	}
-	return rlt.readDelay
+	return rlt.writeDelay
 }
 
 type RateLimitTransportOption func(*RateLimitTransport)
@@ -143,12 +517,16 @@ type RateLimitTransportOption func(*RateLimitTransport)
 func NewRateLimitTransport(rt http.RoundTripper, options ...RateLimitTransportOption) *RateLimitTransport {
 	// Default to 1 second of write delay if none is provided
 	// Default to no read delay if none is provided
-	rlt := &RateLimitTransport{transport: rt, writeDelay: 1 * time.Second, readDelay: 0 * time.Second, parallelRequests: false}
+	// Default to one read at a time if none is provided
+	// Default to 10 rate limited retries waiting one hour in total, the length of the primary rate limit window
+	rlt := &RateLimitTransport{transport: rt, writeDelay: 1 * time.Second, readDelay: 0 * time.Second, parallelRequests: false, maxConcurrentReads: 1, maxRetries: 10, maxWait: 1 * time.Hour}
 
 	for _, opt := range options {
 		opt(rlt)
 	}
 
+	rlt.readSlots = make(chan struct{}, rlt.maxConcurrentReads)
+
 	return rlt
 }
 
@@ -173,6 +551,343 @@ func WithParallelRequests(p bool) RateLimitTransportOption {
 	}
 }
 
+// WithMaxConcurrentReads is used to allow several read requests at once while writes stay serialized
+func WithMaxConcurrentReads(n int) RateLimitTransportOption {
+	return func(rlt *RateLimitTransport) {
+		if n > 0 {
+			rlt.maxConcurrentReads = n
+		}
+	}
+}
+
+// WithRateLimitBudget is used to spread the remaining requests over the rate
+// limit window once fewer than threshold remain, keeping reserve requests
+// for other clients sharing the same credentials
+func WithRateLimitBudget(threshold int, reserve int) RateLimitTransportOption {
+	return func(rlt *RateLimitTransport) {
+		rlt.budgetThreshold = threshold
+		rlt.budgetReserve = reserve
+	}
+}
+
+// WithRateLimitRetries is used to bound how many times and for how long in
+// total a rate limited request is retried
+func WithRateLimitRetries(n int, maxWait time.Duration) RateLimitTransportOption {
+	return func(rlt *RateLimitTransport) {
+		rlt.maxRetries = n
+		rlt.maxWait = maxWait
+	}
+}
+
+// RetryTransport retries requests which failed because of a transient error,
+// such as a 502, 503 or 504 from GitHub or a connection reset, waiting with an
+// exponential backoff between attempts.
+//
+// Requests using non-idempotent methods are only retried when GitHub answered
+// with a response showing that the request was not processed, so that a write
+// is never applied twice.
+type RetryTransport struct {
+	transport         http.RoundTripper
+	maxRetries        int
+	backoffBase       time.Duration
+	backoffMax        time.Duration
+	jitter            bool
+	retryableStatuses map[int]bool
+}
+
+// defaultRetryableStatusCodes are the statuses retried when none are
+// configured. Rate limited responses are left to the RateLimitTransport,
+// which waits for as long as GitHub asks and gives up on its own.
+var defaultRetryableStatusCodes = []int{
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// isUnprocessedResponse reports whether resp guarantees that GitHub did not act
+// on the request, making it safe to retry non-idempotent requests. A 502 or
+// 504 may be returned after the work was done, e.g. once a repository has been
+// created, so only a 429 or a 503 asking to come back later qualify.
+func isUnprocessedResponse(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		_, ok := parseRetryAfter(resp)
+		return ok
+	}
+	return false
+}
+
+func (rt *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		r, err := requestForAttempt(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := rt.transport.RoundTrip(r)
+		if attempt >= rt.maxRetries || !rt.shouldRetry(r, resp, err) {
+			return resp, err
+		}
+
+		delay := rt.backoff(attempt, resp)
+		if err != nil {
+			log.Printf("[DEBUG] %s %s failed: %s; retrying in %s (retry %d of %d)",
+				req.Method, req.URL.Path, err, delay, attempt+1, rt.maxRetries)
+		} else {
+			log.Printf("[DEBUG] %s %s returned %d (X-GitHub-Request-Id: %s); retrying in %s (retry %d of %d)",
+				req.Method, req.URL.Path, resp.StatusCode, resp.Header.Get("X-GitHub-Request-Id"),
+				delay, attempt+1, rt.maxRetries)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		if err := sleepContext(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry decides whether the outcome of a request is worth another attempt
+func (rt *RetryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body has been consumed and cannot be sent again
+		return false
+	}
+
+	if err != nil {
+		if req.Context().Err() != nil {
+			return false
+		}
+		// The request may have reached GitHub before the connection broke
+		return isIdempotentRequest(req) && isTransientError(err)
+	}
+
+	if !rt.retryableStatuses[resp.StatusCode] {
+		return false
+	}
+
+	return isIdempotentRequest(req) || isUnprocessedResponse(resp)
+}
+
+// backoff returns how long to wait before the given retry. The delay grows
+// exponentially from the base up to the cap, optionally with jitter, and never
+// undercuts a Retry-After header sent by GitHub.
+func (rt *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	delay := rt.backoffBase
+	for i := 0; i < attempt && delay < rt.backoffMax; i++ {
+		delay *= 2
+	}
+	if delay > rt.backoffMax {
+		delay = rt.backoffMax
+	}
+
+	if rt.jitter && delay > 1 {
+		// Equal jitter: keep half of the delay and randomize the rest
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+	}
+
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp); ok && retryAfter > delay {
+			delay = retryAfter
+		}
+	}
+
+	return delay
+}
+
+type RetryTransportOption func(*RetryTransport)
+
+// NewRetryTransport takes in an http.RoundTripper and a variadic list of
+// optional functions that modify the RetryTransport struct itself.
+func NewRetryTransport(rt http.RoundTripper, options ...RetryTransportOption) *RetryTransport {
+	// Default to three retries, starting at one second and waiting 30 seconds at most
+	retryTransport := &RetryTransport{
+		transport:   rt,
+		maxRetries:  3,
+		backoffBase: 1 * time.Second,
+		backoffMax:  30 * time.Second,
+		jitter:      true,
+	}
+	WithRetryableStatusCodes(defaultRetryableStatusCodes...)(retryTransport)
+
+	for _, opt := range options {
+		opt(retryTransport)
+	}
+
+	return retryTransport
+}
+
+// WithMaxRetries is used to set how many times a request is retried
+func WithMaxRetries(n int) RetryTransportOption {
+	return func(rt *RetryTransport) {
+		rt.maxRetries = n
+	}
+}
+
+// WithRetryBackoff is used to set the initial delay between retries and its cap
+func WithRetryBackoff(base time.Duration, max time.Duration) RetryTransportOption {
+	return func(rt *RetryTransport) {
+		rt.backoffBase = base
+		rt.backoffMax = max
+	}
+}
+
+// WithRetryJitter is used to randomize the delay between retries
+func WithRetryJitter(jitter bool) RetryTransportOption {
+	return func(rt *RetryTransport) {
+		rt.jitter = jitter
+	}
+}
+
+// WithRetryableStatusCodes is used to set the response statuses which are retried
+func WithRetryableStatusCodes(codes ...int) RetryTransportOption {
+	return func(rt *RetryTransport) {
+		rt.retryableStatuses = make(map[int]bool, len(codes))
+		for _, code := range codes {
+			rt.retryableStatuses[code] = true
+		}
+	}
+}
+
+// requestForAttempt returns the request to send for the given attempt. Retries
+// get a copy of the request with a fresh body, as the original body has been
+// consumed by the first attempt.
+func requestForAttempt(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	r.Body = body
+	return r, nil
+}
+
+// isTransientError reports whether a transport error is likely to go away
+// when the request is sent again.
+func isTransientError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// isIdempotentRequest reports whether sending the request twice has the same
+// effect as sending it once. GraphQL queries are sent as POST requests, but
+// unlike mutations they only read data.
+func isIdempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	case "POST":
+		return isGraphQLRequest(req) && !isGraphQLMutation(req)
+	}
+	return false
+}
+
+// requestResource describes the Terraform resource a request is made for, as
+// far as it is known: its type followed by its ID.
+func requestResource(ctx context.Context) string {
+	var parts []string
+	if resourceType, ok := ctx.Value(ctxResourceType).(string); ok && resourceType != "" {
+		parts = append(parts, resourceType)
+	}
+	if id, ok := ctx.Value(ctxId).(string); ok && id != "" {
+		parts = append(parts, id)
+	}
+	return strings.Join(parts, " ")
+}
+
+// sleepContext waits for d, returning early with the error of ctx when it is
+// cancelled, e.g. by an interrupted Terraform run or an expired resource timeout.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func isGraphQLRequest(req *http.Request) bool {
+	return strings.HasSuffix(strings.TrimSuffix(req.URL.Path, "/"), "/graphql")
+}
+
+// isGraphQLMutation reports whether a GraphQL request body holds a mutation.
+// Requests whose body cannot be inspected are assumed to be mutations.
+func isGraphQLMutation(req *http.Request) bool {
+	if req.GetBody == nil {
+		return true
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return true
+	}
+	defer body.Close()
+
+	var payload struct {
+		Query string `json:"query"`
+	}
+	if err := json.NewDecoder(body).Decode(&payload); err != nil {
+		return true
+	}
+
+	return strings.HasPrefix(strings.TrimSpace(payload.Query), "mutation")
+}
+
+// readOnlyTransport rejects every request which could modify data on GitHub,
+// so that a provider configured with read_only can never apply changes.
+// GraphQL queries are allowed even though they are sent with POST.
+type readOnlyTransport struct {
+	transport http.RoundTripper
+}
+
+func (rot *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isReadRequest(req) {
+		return rot.transport.RoundTrip(req)
+	}
+
+	request := fmt.Sprintf("%s %s", req.Method, req.URL.Path)
+	operation := request
+	if isGraphQLRequest(req) {
+		operation = "GraphQL mutation"
+	}
+
+	// Without a resource type, e.g. for calls made outside of a CRUD function,
+	// the ID alone does not say what is being modified, so name the request.
+	if resourceType, ok := req.Context().Value(ctxResourceType).(string); ok && resourceType != "" {
+		return nil, fmt.Errorf("provider is configured with read_only; refusing to send %s for resource %s", operation, requestResource(req.Context()))
+	}
+	if operation != request {
+		return nil, fmt.Errorf("provider is configured with read_only; refusing to send %s (%s)", operation, request)
+	}
+	return nil, fmt.Errorf("provider is configured with read_only; refusing to send %s", request)
+}
+
+func NewReadOnlyTransport(rt http.RoundTripper) *readOnlyTransport {
+	return &readOnlyTransport{transport: rt}
+}
+
 // drainBody reads all of b to memory and then returns two equivalent
 // ReadClosers yielding the same bytes.
 func drainBody(b io.ReadCloser) (r1, r2 io.ReadCloser, err error) {
@@ -190,6 +905,15 @@ func drainBody(b io.ReadCloser) (r1, r2 io.ReadCloser, err error) {
 	return io.NopCloser(&buf), io.NopCloser(bytes.NewReader(buf.Bytes())), nil
 }
 
+// isReadRequest reports whether a request only reads data. GraphQL queries
+// are sent with POST, but unlike mutations they are reads.
+func isReadRequest(req *http.Request) bool {
+	if req.Method == "POST" && isGraphQLRequest(req) {
+		return !isGraphQLMutation(req)
+	}
+	return !isWriteMethod(req.Method)
+}
+
 func isWriteMethod(method string) bool {
 	switch method {
 	case "POST", "PATCH", "PUT", "DELETE":
@@ -0,0 +1,324 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// auditLogEntry is one line of the audit log, describing an API call
+type auditLogEntry struct {
+	Time               string          `json:"time"`
+	Method             string          `json:"method"`
+	Path               string          `json:"path"`
+	GraphQLOperation   string          `json:"graphql_operation,omitempty"`
+	Status             int             `json:"status,omitempty"`
+	RequestID          string          `json:"request_id,omitempty"`
+	RateLimitRemaining *int            `json:"rate_limit_remaining,omitempty"`
+	LatencyMs          int64           `json:"latency_ms"`
+	ResourceType       string          `json:"resource_type,omitempty"`
+	ResourceID         string          `json:"resource_id,omitempty"`
+	RequestBody        json.RawMessage `json:"request_body,omitempty"`
+	Error              string          `json:"error,omitempty"`
+}
+
+// auditLogSummary counts the API calls made for a resource type
+type auditLogSummary struct {
+	Calls     int   `json:"calls"`
+	Errors    int   `json:"errors"`
+	LatencyMs int64 `json:"latency_ms"`
+}
+
+// AuditLog writes the API calls of the provider to a file as JSON lines. A
+// summary of the calls per resource type is kept up to date next to it after
+// every call, and appended to the log when it is closed.
+//
+// Terraform starts a provider process for each operation, e.g. for the plan
+// and for the apply of terraform apply, so the summary covers the calls of
+// one operation. The process may be killed without being closed, which is
+// why the summary file does not wait for Close.
+type AuditLog struct {
+	path    string
+	file    *os.File
+	summary map[string]*auditLogSummary
+	m       sync.Mutex
+}
+
+// auditLogs are shared by every provider of the process writing to the same
+// file, so that their lines do not interleave and the summary covers them all.
+var (
+	auditLogs      = make(map[string]*AuditLog)
+	auditLogsMutex sync.Mutex
+)
+
+// OpenAuditLog returns the audit log writing to path, appending to the file
+// if it already exists.
+func OpenAuditLog(path string) (*AuditLog, error) {
+	auditLogsMutex.Lock()
+	defer auditLogsMutex.Unlock()
+
+	if a, ok := auditLogs[path]; ok {
+		return a, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &AuditLog{path: path, file: file, summary: make(map[string]*auditLogSummary)}
+	// Replace the summary of the previous operation even if no call is made
+	if err := a.writeSummaryFile(); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	auditLogs[path] = a
+	return a, nil
+}
+
+// CloseAuditLogs appends the summary of every open audit log and closes them.
+// It is called when the provider process shuts down gracefully.
+func CloseAuditLogs() {
+	auditLogsMutex.Lock()
+	defer auditLogsMutex.Unlock()
+
+	for path, a := range auditLogs {
+		if err := a.Close(); err != nil {
+			log.Printf("[WARN] Unable to close audit log %s: %s", path, err)
+		}
+		delete(auditLogs, path)
+	}
+}
+
+func (a *AuditLog) write(v interface{}) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = a.file.Write(append(line, '\n'))
+	return err
+}
+
+func (a *AuditLog) record(entry *auditLogEntry) {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	resourceType := entry.ResourceType
+	if resourceType == "" {
+		resourceType = "provider"
+	}
+	summary, ok := a.summary[resourceType]
+	if !ok {
+		summary = &auditLogSummary{}
+		a.summary[resourceType] = summary
+	}
+	summary.Calls++
+	summary.LatencyMs += entry.LatencyMs
+	if entry.Error != "" || entry.Status >= http.StatusBadRequest {
+		summary.Errors++
+	}
+
+	if err := a.write(entry); err != nil {
+		log.Printf("[WARN] Unable to write to audit log %s: %s", a.path, err)
+	}
+	if err := a.writeSummaryFile(); err != nil {
+		log.Printf("[WARN] Unable to write the audit log summary %s: %s", auditSummaryPath(a.path), err)
+	}
+}
+
+// auditSummaryPath is the file holding the summary of the audit log at path
+func auditSummaryPath(path string) string {
+	return path + ".summary.json"
+}
+
+// summaryLine returns the summary of the calls recorded so far
+func (a *AuditLog) summaryLine() map[string]interface{} {
+	total := 0
+	for _, summary := range a.summary {
+		total += summary.Calls
+	}
+	return map[string]interface{}{
+		"time":    time.Now().UTC().Format(time.RFC3339Nano),
+		"summary": a.summary,
+		"calls":   total,
+	}
+}
+
+// writeSummaryFile replaces the summary file with the summary of the calls
+// recorded so far. It is written to a temporary file first so that readers
+// never see a partial summary.
+func (a *AuditLog) writeSummaryFile() error {
+	data, err := json.Marshal(a.summaryLine())
+	if err != nil {
+		return err
+	}
+
+	path := auditSummaryPath(a.path)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Close writes the summary of the calls per resource type and closes the file
+func (a *AuditLog) Close() error {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	resourceTypes := make([]string, 0, len(a.summary))
+	for resourceType := range a.summary {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+	for _, resourceType := range resourceTypes {
+		summary := a.summary[resourceType]
+		log.Printf("[INFO] %s: %d GitHub API calls, %d errors, %dms", resourceType, summary.Calls, summary.Errors, summary.LatencyMs)
+	}
+
+	err := a.write(a.summaryLine())
+	if closeErr := a.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// auditTransport records every API call it sends to an AuditLog. Request
+// bodies are written with their sensitive fields redacted, and left out
+// entirely for the secrets endpoints.
+type auditTransport struct {
+	transport http.RoundTripper
+	log       *AuditLog
+}
+
+func (at *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry := &auditLogEntry{
+		Time:   time.Now().UTC().Format(time.RFC3339Nano),
+		Method: req.Method,
+		Path:   req.URL.Path,
+	}
+	if resourceType, ok := req.Context().Value(ctxResourceType).(string); ok {
+		entry.ResourceType = resourceType
+	}
+	if id, ok := req.Context().Value(ctxId).(string); ok {
+		entry.ResourceID = id
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		if isGraphQLRequest(req) {
+			entry.GraphQLOperation = graphQLOperation(body)
+		}
+		entry.RequestBody = auditRequestBody(req.URL.Path, entry.ResourceType, body)
+	}
+
+	start := time.Now()
+	resp, err := at.transport.RoundTrip(req)
+	entry.LatencyMs = time.Since(start).Milliseconds()
+
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if resp != nil {
+		entry.Status = resp.StatusCode
+		entry.RequestID = resp.Header.Get("X-GitHub-Request-Id")
+		if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+			entry.RateLimitRemaining = &remaining
+		}
+	}
+
+	at.log.record(entry)
+	return resp, err
+}
+
+// auditRedactedFields are the request body fields holding credentials, and
+// the key ID identifying the public key a secret value was encrypted with
+var auditRedactedFields = map[string]bool{
+	"token":           true,
+	"encrypted_value": true,
+	"key_id":          true,
+	"secret":          true,
+	"password":        true,
+	"private_key":     true,
+	"client_secret":   true,
+	"pem":             true,
+}
+
+// auditRequestBody returns the request body to write to the audit log. The
+// bodies sent to the secrets endpoints are left out whichever resource sent
+// them, as calls made outside of a CRUD function carry no resource type.
+func auditRequestBody(path string, resourceType string, body []byte) json.RawMessage {
+	if isSecretsPath(path) || strings.Contains(resourceType, "secret") {
+		return json.RawMessage(strconv.Quote(redactedValue))
+	}
+	if !json.Valid(body) {
+		return nil
+	}
+	return redactJSON(body, auditRedactedFields)
+}
+
+// isSecretsPath reports whether path belongs to the secrets endpoints of
+// Actions, Dependabot or Codespaces
+func isSecretsPath(path string) bool {
+	return strings.Contains(path, "/secrets/") || strings.HasSuffix(path, "/secrets")
+}
+
+var (
+	graphQLOperationRegexp = regexp.MustCompile(`^\s*(query|mutation|subscription)\s*([_A-Za-z][_0-9A-Za-z]*)?`)
+	graphQLFieldRegexp     = regexp.MustCompile(`^\s*([_A-Za-z][_0-9A-Za-z]*)\s*(?::\s*([_A-Za-z][_0-9A-Za-z]*))?`)
+)
+
+// graphQLOperation describes the operation of a GraphQL request by its type
+// and name, or by its first field for the anonymous operations sent by
+// githubv4, e.g. "mutation createBranchProtectionRule".
+func graphQLOperation(body []byte) string {
+	var request struct {
+		Query string `json:"query"`
+	}
+	if json.Unmarshal(body, &request) != nil || request.Query == "" {
+		return ""
+	}
+
+	operation := "query"
+	if match := graphQLOperationRegexp.FindStringSubmatch(request.Query); match != nil {
+		operation = match[1]
+		if match[2] != "" {
+			return fmt.Sprintf("%s %s", operation, match[2])
+		}
+	}
+
+	i := strings.Index(request.Query, "{")
+	if i < 0 {
+		return operation
+	}
+	match := graphQLFieldRegexp.FindStringSubmatch(request.Query[i+1:])
+	if match == nil {
+		return operation
+	}
+	if match[2] != "" {
+		// An aliased field
+		return fmt.Sprintf("%s %s", operation, match[2])
+	}
+	return fmt.Sprintf("%s %s", operation, match[1])
+}
+
+// NewAuditTransport returns a transport recording every API call to log
+func NewAuditTransport(rt http.RoundTripper, log *AuditLog) *auditTransport {
+	return &auditTransport{transport: rt, log: log}
+}
@@ -0,0 +1,120 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAuditTransport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-GitHub-Request-Id", "ABCD:1234")
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	auditLog, err := OpenAuditLog(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	transport := NewAuditTransport(http.DefaultTransport, auditLog)
+
+	send := func(resourceType, method, url, body string) {
+		ctx := context.WithValue(context.Background(), ctxResourceType, resourceType)
+		ctx = context.WithValue(ctx, ctxId, "test")
+		req, _ := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	send("github_repository", "POST", ts.URL+"/graphql", `{"query": "mutation($input:CreateBranchProtectionRuleInput!){createBranchProtectionRule(input: $input){clientMutationId}}"}`)
+	send("github_actions_secret", "PUT", ts.URL+"/repos/o/r/actions/secrets/s", `{"encrypted_value": "abc", "key_id": "1"}`)
+	send("github_repository_webhook", "POST", ts.URL+"/repos/o/r/hooks", `{"config": {"url": "https://example.com", "secret": "xyz"}}`)
+	send("", "PUT", ts.URL+"/orgs/o/dependabot/secrets/s", `{"encrypted_value": "def", "key_id": "1"}`)
+	send("", "POST", ts.URL+"/user/keys", `{"title": "t", "key_id": "ghi"}`)
+	send("github_repository", "GET", ts.URL+"/missing", "")
+
+	summaryData, err := os.ReadFile(auditSummaryPath(path))
+	if err != nil {
+		t.Fatalf("Expected the summary to be written before the audit log is closed, got: %s", err)
+	}
+	var current struct {
+		Calls int `json:"calls"`
+	}
+	if err := json.Unmarshal(summaryData, &current); err != nil || current.Calls != 6 {
+		t.Fatalf("Expected 6 calls in the summary file, got: %s", summaryData)
+	}
+
+	CloseAuditLogs()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for _, secret := range []string{"abc", "xyz", "def", "ghi"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("Expected %s to be redacted from the audit log, got: %s", secret, data)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 7 {
+		t.Fatalf("Expected 6 calls and a summary, got: %s", data)
+	}
+
+	var entry auditLogEntry
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if entry.GraphQLOperation != "mutation createBranchProtectionRule" {
+		t.Fatalf("Expected the GraphQL operation to be recorded, got: %s", lines[0])
+	}
+	if entry.RequestID != "ABCD:1234" || entry.RateLimitRemaining == nil || *entry.RateLimitRemaining != 4999 {
+		t.Fatalf("Expected the request ID and rate limit to be recorded, got: %s", lines[0])
+	}
+	if entry.ResourceType != "github_repository" || entry.ResourceID != "test" {
+		t.Fatalf("Expected the resource to be recorded, got: %s", lines[0])
+	}
+
+	var summary struct {
+		Calls   int                         `json:"calls"`
+		Summary map[string]*auditLogSummary `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(lines[6]), &summary); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if summary.Calls != 6 {
+		t.Fatalf("Expected 6 calls in the summary, got: %s", lines[6])
+	}
+	if s := summary.Summary["github_repository"]; s == nil || s.Calls != 2 || s.Errors != 1 {
+		t.Fatalf("Expected 2 calls and 1 error for github_repository, got: %s", lines[6])
+	}
+}
+
+func TestGraphQLOperation(t *testing.T) {
+	cases := map[string]string{
+		`{"query": "query($owner:String!){repository(owner: $owner){id}}"}`:     "query repository",
+		`{"query": "{viewer{login}}"}`:                                          "query viewer",
+		`{"query": "query getTeams { organization(login: \"o\") { id } }"}`:     "query getTeams",
+		`{"query": "mutation{deleteRef: deleteRef(input: {refId: \"1\"}){x}}"}`: "mutation deleteRef",
+		`not json`: "",
+	}
+	for body, expected := range cases {
+		if got := graphQLOperation([]byte(body)); got != expected {
+			t.Errorf("Expected %q for %s, got %q", expected, body, got)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package github
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// diskResponseCacheMaxAge is how long responses are kept on disk. Older
+// entries are not served, and are removed when a provider opens the cache.
+const diskResponseCacheMaxAge = 7 * 24 * time.Hour
+
+// cachedResponse is a GitHub API response stored by a ResponseCache
+type cachedResponse struct {
+	URL    string      `json:"url"`
+	ETag   string      `json:"etag"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+	Stored time.Time   `json:"stored"`
+}
+
+// ResponseCache stores the bodies of GitHub API responses along with their
+// ETag, so that requests can be made conditional and answered from the cache
+// when GitHub replies with 304 Not Modified.
+type ResponseCache interface {
+	Get(key string) (*cachedResponse, bool)
+	Set(key string, response *cachedResponse)
+}
+
+// memoryResponseCache keeps responses for the lifetime of the provider process
+type memoryResponseCache struct {
+	entries map[string]*cachedResponse
+
+	m sync.RWMutex
+}
+
+func NewMemoryResponseCache() ResponseCache {
+	return &memoryResponseCache{entries: make(map[string]*cachedResponse)}
+}
+
+func (c *memoryResponseCache) Get(key string) (*cachedResponse, bool) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	response, ok := c.entries[key]
+	return response, ok
+}
+
+func (c *memoryResponseCache) Set(key string, response *cachedResponse) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.entries[key] = response
+}
+
+// diskResponseCache keeps responses in a directory, one file per cache key,
+// so that they survive between Terraform runs, for up to maxAge.
+type diskResponseCache struct {
+	dir    string
+	maxAge time.Duration
+}
+
+// NewDiskResponseCache returns a ResponseCache storing its entries in dir,
+// creating the directory if needed and removing the expired entries.
+func NewDiskResponseCache(dir string) (ResponseCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	c := &diskResponseCache{dir: dir, maxAge: diskResponseCacheMaxAge}
+	c.prune()
+	return c, nil
+}
+
+// prune removes the entries written longer than maxAge ago
+func (c *diskResponseCache) prune() {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		log.Printf("[WARN] Unable to list the response cache in %s: %s", c.dir, err)
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) <= c.maxAge {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.dir, entry.Name())); err != nil && !os.IsNotExist(err) {
+			log.Printf("[WARN] Unable to remove expired response cache entry %s: %s", entry.Name(), err)
+		}
+	}
+}
+
+func (c *diskResponseCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *diskResponseCache) Get(key string) (*cachedResponse, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var response cachedResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		log.Printf("[WARN] Ignoring unreadable response cache entry for %s: %s", key, err)
+		return nil, false
+	}
+	if time.Since(response.Stored) > c.maxAge {
+		return nil, false
+	}
+
+	return &response, true
+}
+
+func (c *diskResponseCache) Set(key string, response *cachedResponse) {
+	stored := *response
+	stored.Stored = time.Now()
+	data, err := json.Marshal(&stored)
+	if err != nil {
+		log.Printf("[WARN] Unable to encode response cache entry for %s: %s", key, err)
+		return
+	}
+
+	// Write to a temporary file first so that concurrent readers never see
+	// a partially written entry
+	tmp, err := os.CreateTemp(c.dir, "entry-*.tmp")
+	if err != nil {
+		log.Printf("[WARN] Unable to write response cache entry for %s: %s", key, err)
+		return
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), c.path(key))
+	}
+	if err != nil {
+		log.Printf("[WARN] Unable to write response cache entry for %s: %s", key, err)
+	}
+}
+
+// responseCacheKey identifies a cacheable request. The Accept and API version
+// headers are part of the key because they change the representation GitHub
+// returns, and the credentials are because they change what it returns. The
+// credentials are those of scope, which identifies the credentials added by
+// the transports below the cache, and of the Authorization header if already
+// set; only a hash of them is kept.
+func responseCacheKey(req *http.Request, scope string) string {
+	credentials := sha256.Sum256([]byte(scope + "\n" + req.Header.Get("Authorization")))
+	return strings.Join([]string{
+		req.URL.String(),
+		req.Header.Get("Accept"),
+		req.Header.Get("X-GitHub-Api-Version"),
+		hex.EncodeToString(credentials[:]),
+	}, " ")
+}
+
+// cachedRoundTrip sends a GET request conditionally when a response for it has
+// been cached, serving the cached body when GitHub replies 304 Not Modified.
+// Conditional requests answered with 304 do not count against the primary
+// rate limit.
+func (ett *etagTransport) cachedRoundTrip(req *http.Request) (*http.Response, error) {
+	key := responseCacheKey(req, ett.cacheScope)
+
+	cached, ok := ett.cache.Get(key)
+	if ok {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	resp, err := ett.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		log.Printf("[DEBUG] Serving %s from the response cache", req.URL.Path)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		header := cached.Header.Clone()
+		// Keep the fresh rate limit and request headers of the 304 response
+		for name, values := range resp.Header {
+			header[name] = values
+		}
+		header.Del("Content-Length")
+
+		resp.StatusCode = http.StatusOK
+		resp.Status = "200 OK"
+		resp.Header = header
+		resp.Body = io.NopCloser(bytes.NewReader(cached.Body))
+		resp.ContentLength = int64(len(cached.Body))
+		return resp, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	ett.cache.Set(key, &cachedResponse{
+		URL:    req.URL.String(),
+		ETag:   etag,
+		Header: resp.Header.Clone(),
+		Body:   body,
+	})
+
+	return resp, nil
+}
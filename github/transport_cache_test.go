@@ -0,0 +1,171 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v53/github"
+)
+
+func TestEtagTransport_responseCache(t *testing.T) {
+	responses := func() []*mockResponse {
+		return []*mockResponse{
+			{
+				ExpectedUri:  "/repos/test/blah",
+				ResponseBody: `{"id": 1234}`,
+				StatusCode:   200,
+				ResponseHeaders: map[string]string{
+					"ETag": `"abc"`,
+				},
+			},
+			{
+				ExpectedUri: "/repos/test/blah",
+				ExpectedHeaders: map[string]string{
+					"If-None-Match": `"abc"`,
+				},
+				StatusCode: 304,
+				ResponseHeaders: map[string]string{
+					"X-RateLimit-Remaining": "4999",
+				},
+			},
+		}
+	}
+
+	newClient := func(ts string, cache ResponseCache) *github.Client {
+		httpClient := &http.Client{Transport: NewEtagTransport(http.DefaultTransport, WithResponseCache(cache))}
+		client := github.NewClient(httpClient)
+		u, _ := url.Parse(ts + "/")
+		client.BaseURL = u
+		return client
+	}
+
+	assertRepository := func(t *testing.T, client *github.Client) *github.Response {
+		r, resp, err := client.Repositories.Get(context.Background(), "test", "blah")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.GetID() != 1234 {
+			t.Fatalf("Expected ID to be 1234, got: %d", r.GetID())
+		}
+		return resp
+	}
+
+	t.Run("serves an unmodified response from memory", func(t *testing.T) {
+		ts := githubApiMock(responses())
+		defer ts.Close()
+
+		client := newClient(ts.URL, NewMemoryResponseCache())
+
+		assertRepository(t, client)
+		resp := assertRepository(t, client)
+
+		if resp.Rate.Remaining != 4999 {
+			t.Fatalf("Expected the rate limit of the 304 response, got: %d", resp.Rate.Remaining)
+		}
+	})
+
+	t.Run("serves an unmodified response from disk across providers", func(t *testing.T) {
+		ts := githubApiMock(responses())
+		defer ts.Close()
+
+		dir := t.TempDir()
+
+		cache, err := NewDiskResponseCache(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertRepository(t, newClient(ts.URL, cache))
+
+		cache, err = NewDiskResponseCache(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertRepository(t, newClient(ts.URL, cache))
+	})
+
+	t.Run("leaves requests carrying a resource etag alone", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri: "/repos/test/blah",
+				ExpectedHeaders: map[string]string{
+					"If-None-Match": "something",
+				},
+				StatusCode: 304,
+			},
+		})
+		defer ts.Close()
+
+		client := newClient(ts.URL, NewMemoryResponseCache())
+
+		ctx := context.WithValue(context.Background(), ctxEtag, "something")
+		_, resp, _ := client.Repositories.Get(ctx, "test", "blah")
+		if resp.StatusCode != http.StatusNotModified {
+			t.Fatalf("Expected the 304 to reach the resource, got: %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("expires entries on disk", func(t *testing.T) {
+		dir := t.TempDir()
+
+		cache, err := NewDiskResponseCache(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cache.Set("key", &cachedResponse{ETag: `"abc"`, Body: []byte(`{}`)})
+		if _, ok := cache.Get("key"); !ok {
+			t.Fatal("Expected the entry to be cached")
+		}
+
+		expired := time.Now().Add(-diskResponseCacheMaxAge - time.Hour)
+		path := cache.(*diskResponseCache).path("key")
+		if err := os.Chtimes(path, expired, expired); err != nil {
+			t.Fatal(err)
+		}
+		cache.(*diskResponseCache).maxAge = 0
+		if _, ok := cache.Get("key"); ok {
+			t.Fatal("Expected the expired entry not to be served")
+		}
+
+		if _, err := NewDiskResponseCache(dir); err != nil {
+			t.Fatal(err)
+		}
+		if entries, _ := filepath.Glob(filepath.Join(dir, "*.json")); len(entries) != 0 {
+			t.Fatalf("Expected the expired entry to be removed, got: %v", entries)
+		}
+	})
+}
+
+func TestResponseCacheKey(t *testing.T) {
+	newRequest := func(header map[string]string) *http.Request {
+		req, _ := http.NewRequest("GET", "https://api.github.com/repos/test/blah", nil)
+		for name, value := range header {
+			req.Header.Set(name, value)
+		}
+		return req
+	}
+
+	key := responseCacheKey(newRequest(nil), "token a")
+	if key != responseCacheKey(newRequest(nil), "token a") {
+		t.Fatal("Expected the same request to have the same key")
+	}
+	for name, other := range map[string]string{
+		"scope":         responseCacheKey(newRequest(nil), "token b"),
+		"authorization": responseCacheKey(newRequest(map[string]string{"Authorization": "Bearer b"}), "token a"),
+		"accept":        responseCacheKey(newRequest(map[string]string{"Accept": "application/vnd.github.raw"}), "token a"),
+		"api version":   responseCacheKey(newRequest(map[string]string{"X-GitHub-Api-Version": "2022-11-28"}), "token a"),
+	} {
+		if other == key {
+			t.Errorf("Expected the %s to change the key", name)
+		}
+	}
+	if strings.Contains(key, "token a") {
+		t.Fatalf("Expected the key not to contain the credentials, got: %s", key)
+	}
+}
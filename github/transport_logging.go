@@ -0,0 +1,154 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
+)
+
+// loggingRedactedHeaders are the headers carrying credentials, whose values
+// are never logged
+var loggingRedactedHeaders = map[string]bool{
+	"authorization":       true,
+	"cookie":              true,
+	"set-cookie":          true,
+	"x-github-otp":        true,
+	"x-hub-signature":     true,
+	"x-hub-signature-256": true,
+}
+
+// loggingRedactedFields are the JSON fields of request and response bodies
+// carrying credentials: encrypted secret values, installation and
+// registration tokens, webhook secrets and the like
+var loggingRedactedFields = map[string]bool{
+	"token":           true,
+	"encrypted_value": true,
+	"secret":          true,
+	"password":        true,
+	"private_key":     true,
+	"client_secret":   true,
+	"pem":             true,
+}
+
+// loggingRedactedPatterns match GitHub credentials wherever they appear,
+// e.g. in URLs or bodies which are not JSON
+var loggingRedactedPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\bgh[pousr]_[0-9A-Za-z]{36,}\b`),
+	regexp.MustCompile(`\bgithub_pat_[0-9A-Za-z_]{22,}\b`),
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`),
+}
+
+// loggingTransport logs the requests and responses of the GitHub API at the
+// DEBUG level, like logging.NewTransport of the SDK, with credentials
+// redacted from the headers and bodies so that the logs are safe to keep.
+type loggingTransport struct {
+	name      string
+	transport http.RoundTripper
+	patterns  []*regexp.Regexp
+}
+
+func (lt *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if logging.IsDebugOrHigher() {
+		reqData, err := httputil.DumpRequestOut(req, true)
+		if err == nil {
+			log.Printf("[DEBUG] "+logRequestMessage, lt.name, lt.redact(reqData))
+		} else {
+			log.Printf("[ERROR] %s API Request error: %#v", lt.name, err)
+		}
+	}
+
+	resp, err := lt.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if logging.IsDebugOrHigher() {
+		respData, err := httputil.DumpResponse(resp, true)
+		if err == nil {
+			log.Printf("[DEBUG] "+logResponseMessage, lt.name, lt.redact(respData))
+		} else {
+			log.Printf("[ERROR] %s API Response error: %#v", lt.name, err)
+		}
+	}
+
+	return resp, nil
+}
+
+// redact removes the credentials from a dumped request or response and
+// pretty-prints JSON bodies
+func (lt *loggingTransport) redact(dump []byte) string {
+	// The headers end at the first blank line, and the body follows
+	head, body := string(dump), ""
+	separator := "\r\n\r\n"
+	if i := strings.Index(head, separator); i >= 0 {
+		head, body = head[:i], head[i+len(separator):]
+	} else if i := strings.Index(head, "\n\n"); i >= 0 {
+		separator = "\n\n"
+		head, body = head[:i], head[i+len(separator):]
+	} else {
+		separator = ""
+	}
+
+	lines := strings.Split(head, "\n")
+	chunked := false
+	for i, line := range lines[1:] {
+		line = strings.TrimSuffix(line, "\r")
+		colon := strings.Index(line, ":")
+		if colon <= 0 {
+			continue
+		}
+		name := strings.ToLower(line[:colon])
+		if loggingRedactedHeaders[name] {
+			lines[i+1] = line[:colon] + ": " + redactedValue
+		}
+		if name == "transfer-encoding" && strings.Contains(strings.ToLower(line[colon+1:]), "chunked") {
+			chunked = true
+		}
+	}
+
+	if chunked {
+		if unchunked, err := io.ReadAll(httputil.NewChunkedReader(strings.NewReader(body))); err == nil {
+			body = string(unchunked)
+		}
+	}
+	if trimmed := bytes.TrimSpace([]byte(body)); json.Valid(trimmed) {
+		// The whole body is one document, which GitHub usually pretty-prints
+		var out bytes.Buffer
+		if json.Indent(&out, redactJSON(trimmed, loggingRedactedFields), "", " ") == nil {
+			body = out.String()
+		}
+	}
+
+	redacted := strings.Join(lines, "\n") + separator + body
+	for _, pattern := range loggingRedactedPatterns {
+		redacted = pattern.ReplaceAllString(redacted, redactedValue)
+	}
+	for _, pattern := range lt.patterns {
+		redacted = pattern.ReplaceAllString(redacted, redactedValue)
+	}
+	return redacted
+}
+
+const logRequestMessage = `%s API Request Details:
+---[ REQUEST ]---------------------------------------
+%s
+-----------------------------------------------------`
+
+const logResponseMessage = `%s API Response Details:
+---[ RESPONSE ]--------------------------------------
+%s
+-----------------------------------------------------`
+
+// NewLoggingTransport returns a transport logging requests and responses at
+// the DEBUG level, redacting known credentials as well as anything matching
+// the given patterns
+func NewLoggingTransport(name string, rt http.RoundTripper, patterns ...*regexp.Regexp) *loggingTransport {
+	return &loggingTransport{name: name, transport: rt, patterns: patterns}
+}
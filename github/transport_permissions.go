@@ -0,0 +1,143 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// permissionHintTransport explains 403 and 404 responses caused by missing
+// token permissions. GitHub reports which OAuth scopes and fine-grained
+// permissions an endpoint accepts in the response headers; when the token
+// does not have them, or has not been authorized for SAML single sign-on, a
+// hint naming what is needed and the resource concerned is appended to the
+// error message of the response. Since the permissions of fine-grained tokens
+// are not reported, they are only named for 403 responses: a 404 is most
+// likely a resource which does not exist, e.g. one deleted outside Terraform.
+type permissionHintTransport struct {
+	transport http.RoundTripper
+}
+
+func (pht *permissionHintTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := pht.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusNotFound {
+		return resp, nil
+	}
+
+	hint := permissionHint(resp.StatusCode, resp.Header)
+	if hint == "" {
+		return resp, nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		// GitHub answers 404 rather than 403 for resources the token cannot see
+		hint = "GitHub hides resources the token cannot access: " + hint
+	}
+	if resource := requestResource(req.Context()); resource != "" {
+		hint = fmt.Sprintf("%s (resource %s)", hint, resource)
+	}
+	log.Printf("[DEBUG] %s %s returned %d: %s", req.Method, req.URL.Path, resp.StatusCode, hint)
+
+	if err := appendErrorMessage(resp, hint); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func NewPermissionHintTransport(rt http.RoundTripper) *permissionHintTransport {
+	return &permissionHintTransport{transport: rt}
+}
+
+// permissionHint explains from the status code and headers of a response why
+// a token was denied access, or returns an empty string when they do not tell.
+func permissionHint(statusCode int, header http.Header) string {
+	var hints []string
+
+	// Classic tokens report their scopes alongside the scopes the endpoint
+	// accepts, any one of which is enough
+	if _, ok := header["X-Oauth-Scopes"]; ok {
+		accepted := splitHeaderList(header.Get("X-Accepted-OAuth-Scopes"))
+		granted := splitHeaderList(header.Get("X-OAuth-Scopes"))
+		if len(accepted) > 0 && !containsAny(granted, accepted) {
+			has := "no scopes"
+			if len(granted) > 0 {
+				has = strings.Join(granted, ", ")
+			}
+			hints = append(hints, fmt.Sprintf("the token needs one of the OAuth scopes %s but has %s",
+				strings.Join(accepted, ", "), has))
+		}
+	}
+
+	// Fine-grained tokens and GitHub Apps are told the permissions required,
+	// but not whether they have them, which only a 403 implies
+	if permissions := header.Get("X-Accepted-GitHub-Permissions"); permissions != "" && statusCode == http.StatusForbidden {
+		hints = append(hints, fmt.Sprintf("the endpoint requires the permissions %s", permissions))
+	}
+
+	if sso := header.Get("X-GitHub-SSO"); strings.HasPrefix(sso, "required") {
+		hint := "the token must be authorized for SAML single sign-on"
+		if i := strings.Index(sso, "url="); i >= 0 {
+			hint = fmt.Sprintf("%s at %s", hint, strings.TrimSpace(sso[i+len("url="):]))
+		}
+		hints = append(hints, hint)
+	}
+
+	return strings.Join(hints, "; ")
+}
+
+// appendErrorMessage appends text to the message of a GitHub error response,
+// which is what the GitHub clients report as the error.
+func appendErrorMessage(resp *http.Response, text string) error {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	var payload map[string]interface{}
+	if json.Unmarshal(body, &payload) == nil && payload != nil {
+		message, _ := payload["message"].(string)
+		if message == "" {
+			message = http.StatusText(resp.StatusCode)
+		}
+		payload["message"] = fmt.Sprintf("%s: %s", message, text)
+		if data, err := json.Marshal(payload); err == nil {
+			body = data
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	return nil
+}
+
+// splitHeaderList splits a comma separated header value such as X-OAuth-Scopes
+func splitHeaderList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func containsAny(values, candidates []string) bool {
+	for _, v := range values {
+		for _, c := range candidates {
+			if v == c {
+				return true
+			}
+		}
+	}
+	return false
+}
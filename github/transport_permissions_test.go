@@ -0,0 +1,139 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v53/github"
+)
+
+func TestPermissionHintTransport(t *testing.T) {
+	newClient := func(ts string) *github.Client {
+		httpClient := &http.Client{Transport: NewPermissionHintTransport(http.DefaultTransport)}
+		client := github.NewClient(httpClient)
+		u, _ := url.Parse(ts + "/")
+		client.BaseURL = u
+		return client
+	}
+
+	t.Run("names the missing OAuth scopes and the resource", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/orgs/test/teams/blah",
+				ResponseBody: `{"message": "Resource not accessible by integration"}`,
+				StatusCode:   403,
+				ResponseHeaders: map[string]string{
+					"X-OAuth-Scopes":          "repo",
+					"X-Accepted-OAuth-Scopes": "admin:org, read:org",
+				},
+			},
+		})
+		defer ts.Close()
+
+		ctx := context.WithValue(context.Background(), ctxResourceType, "github_team")
+		ctx = context.WithValue(ctx, ctxId, "1234")
+		_, _, err := newClient(ts.URL).Teams.GetTeamBySlug(ctx, "test", "blah")
+
+		ghErr, ok := err.(*github.ErrorResponse)
+		if !ok || ghErr.Response.StatusCode != 403 {
+			t.Fatalf("Expected a 403 error response, got: %v", err)
+		}
+		for _, expected := range []string{"Resource not accessible by integration", "admin:org, read:org", "but has repo", "github_team 1234"} {
+			if !strings.Contains(ghErr.Message, expected) {
+				t.Fatalf("Expected %q in the error message, got: %s", expected, ghErr.Message)
+			}
+		}
+	})
+
+	t.Run("names the fine-grained permissions of a 403", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/repos/test/blah",
+				ResponseBody: `{"message": "Resource not accessible by personal access token"}`,
+				StatusCode:   403,
+				ResponseHeaders: map[string]string{
+					"X-Accepted-GitHub-Permissions": "metadata=read",
+				},
+			},
+		})
+		defer ts.Close()
+
+		_, resp, err := newClient(ts.URL).Repositories.Get(context.Background(), "test", "blah")
+		if resp == nil || resp.StatusCode != 403 {
+			t.Fatalf("Expected the 403 to be preserved, got: %v", err)
+		}
+		if err == nil || !strings.Contains(err.Error(), "the endpoint requires the permissions metadata=read") {
+			t.Fatalf("Expected the permissions in the error, got: %v", err)
+		}
+	})
+
+	t.Run("names SSO authorization but not the fine-grained permissions of a 404", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/repos/test/blah",
+				ResponseBody: `{"message": "Not Found"}`,
+				StatusCode:   404,
+				ResponseHeaders: map[string]string{
+					"X-Accepted-GitHub-Permissions": "metadata=read",
+					"X-GitHub-SSO":                  "required; url=https://github.com/orgs/test/sso?authorization_request=abc",
+				},
+			},
+		})
+		defer ts.Close()
+
+		_, resp, err := newClient(ts.URL).Repositories.Get(context.Background(), "test", "blah")
+		if resp == nil || resp.StatusCode != 404 {
+			t.Fatalf("Expected the 404 to be preserved, got: %v", err)
+		}
+		if err == nil || !strings.Contains(err.Error(), "single sign-on at https://github.com/orgs/test/sso?authorization_request=abc") {
+			t.Fatalf("Expected the SSO authorization in the error, got: %v", err)
+		}
+		if strings.Contains(err.Error(), "metadata=read") {
+			t.Fatalf("Expected the permissions not to be named for a 404, got: %v", err)
+		}
+	})
+
+	t.Run("leaves a 404 alone when only the fine-grained permissions are known", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/repos/test/blah",
+				ResponseBody: `{"message": "Not Found"}`,
+				StatusCode:   404,
+				ResponseHeaders: map[string]string{
+					"X-Accepted-GitHub-Permissions": "metadata=read",
+				},
+			},
+		})
+		defer ts.Close()
+
+		_, _, err := newClient(ts.URL).Repositories.Get(context.Background(), "test", "blah")
+		ghErr, ok := err.(*github.ErrorResponse)
+		if !ok || ghErr.Message != "Not Found" {
+			t.Fatalf("Expected the original error message, got: %v", err)
+		}
+	})
+
+	t.Run("leaves errors alone when the token has an accepted scope", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:  "/repos/test/blah",
+				ResponseBody: `{"message": "Not Found"}`,
+				StatusCode:   404,
+				ResponseHeaders: map[string]string{
+					"X-OAuth-Scopes":          "repo, read:org",
+					"X-Accepted-OAuth-Scopes": "repo",
+				},
+			},
+		})
+		defer ts.Close()
+
+		_, _, err := newClient(ts.URL).Repositories.Get(context.Background(), "test", "blah")
+		ghErr, ok := err.(*github.ErrorResponse)
+		if !ok || ghErr.Message != "Not Found" {
+			t.Fatalf("Expected the original error message, got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,365 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// recorderModeEnv selects whether API interactions are recorded to or
+	// replayed from the cassette named by recorderCassetteEnv
+	recorderModeEnv     = "GITHUB_RECORDER_MODE"
+	recorderCassetteEnv = "GITHUB_RECORDER_CASSETTE"
+
+	recorderModeRecord = "record"
+	recorderModeReplay = "replay"
+
+	redactedValue = "REDACTED"
+)
+
+// recorderEnvironment are the settings of the acceptance tests which shape
+// the requests of a test, and are stored in its cassette to be replayed with
+// the same values. None of them hold credentials.
+var recorderEnvironment = []string{"GITHUB_BASE_URL", "GITHUB_OWNER", "GITHUB_ORGANIZATION"}
+
+// cassette holds the API interactions recorded for a test
+type cassette struct {
+	path         string
+	Environment  map[string]string      `json:"environment,omitempty"`
+	Interactions []*recordedInteraction `json:"interactions"`
+
+	// used marks the interactions already replayed
+	used []bool
+	m    sync.Mutex
+}
+
+type recordedInteraction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// cassettes are shared by every provider configured by a test process, so
+// that the test steps of a test record to and replay from the same cassette.
+var (
+	cassettes      = make(map[string]*cassette)
+	cassettesMutex sync.Mutex
+)
+
+// loadCassette returns the cassette stored at path, reading it from disk the
+// first time it is used. A missing cassette is only an error when replaying.
+func loadCassette(path, mode string) (*cassette, error) {
+	cassettesMutex.Lock()
+	defer cassettesMutex.Unlock()
+
+	if c, ok := cassettes[path]; ok {
+		return c, nil
+	}
+
+	c := &cassette{path: path}
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, c); err != nil {
+			return nil, fmt.Errorf("unable to read cassette %s: %s", path, err)
+		}
+	case os.IsNotExist(err) && mode == recorderModeRecord:
+	default:
+		return nil, fmt.Errorf("unable to read cassette %s: %s", path, err)
+	}
+	c.used = make([]bool, len(c.Interactions))
+
+	cassettes[path] = c
+	return c, nil
+}
+
+// record appends an interaction and saves the cassette, so that nothing is
+// lost when the provider process is stopped.
+func (c *cassette) record(interaction *recordedInteraction) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.Interactions = append(c.Interactions, interaction)
+	c.used = append(c.used, true)
+
+	return c.save()
+}
+
+// setEnvironment records the settings the cassette is recorded with
+func (c *cassette) setEnvironment(environment map[string]string) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.Environment = environment
+	return c.save()
+}
+
+func (c *cassette) save() error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
+
+// replay returns the first interaction matching request which has not been
+// replayed yet. Identical requests are answered in the order they were
+// recorded.
+func (c *cassette) replay(request recordedRequest) (*recordedInteraction, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	for i, interaction := range c.Interactions {
+		if !c.used[i] && interaction.Request.matches(request) {
+			c.used[i] = true
+			return interaction, true
+		}
+	}
+	return nil, false
+}
+
+// matches compares requests on their method, path, query and body, ignoring
+// the host so that a cassette can be replayed against any base URL.
+func (r recordedRequest) matches(other recordedRequest) bool {
+	return r.Method == other.Method && requestPathAndQuery(r.URL) == requestPathAndQuery(other.URL) && r.Body == other.Body
+}
+
+func requestPathAndQuery(rawURL string) string {
+	if i := strings.Index(rawURL, "://"); i >= 0 {
+		rawURL = rawURL[i+len("://"):]
+		if j := strings.Index(rawURL, "/"); j >= 0 {
+			return rawURL[j:]
+		}
+		return "/"
+	}
+	return rawURL
+}
+
+// recorderTransport records the API interactions of the provider to a
+// cassette, or replays them from it without any network access, so that
+// acceptance tests can run hermetically. Credentials are redacted from
+// recorded interactions.
+type recorderTransport struct {
+	transport http.RoundTripper
+	mode      string
+	cassette  *cassette
+}
+
+func (rt *recorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, err := newRecordedRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if rt.mode == recorderModeReplay {
+		interaction, ok := rt.cassette.replay(request)
+		if !ok {
+			return nil, fmt.Errorf("no interaction recorded in %s for %s %s", rt.cassette.path, req.Method, req.URL)
+		}
+		return interaction.Response.httpResponse(req), nil
+	}
+
+	resp, err := rt.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	response := recordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     redactHeaders(resp.Header),
+		Body:       redactRecordedBody(body),
+	}
+	if err := rt.cassette.record(&recordedInteraction{Request: request, Response: response}); err != nil {
+		log.Printf("[WARN] Unable to record %s %s to %s: %s", req.Method, req.URL, rt.cassette.path, err)
+	}
+
+	return resp, nil
+}
+
+func newRecordedRequest(req *http.Request) (recordedRequest, error) {
+	request := recordedRequest{
+		Method: req.Method,
+		URL:    redactPatterns(req.URL.String()),
+		Header: redactHeaders(req.Header),
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return request, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		request.Body = redactRecordedBody(body)
+	}
+
+	return request, nil
+}
+
+func (r recordedResponse) httpResponse(req *http.Request) *http.Response {
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Length", strconv.Itoa(len(r.Body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// NewRecorderTransport returns a transport recording to or replaying from the
+// cassette at path, depending on mode.
+func NewRecorderTransport(rt http.RoundTripper, mode, path string) (*recorderTransport, error) {
+	if mode != recorderModeRecord && mode != recorderModeReplay {
+		return nil, fmt.Errorf("%s must be %q or %q, got %q", recorderModeEnv, recorderModeRecord, recorderModeReplay, mode)
+	}
+	if path == "" {
+		return nil, fmt.Errorf("%s must be set when %s is set", recorderCassetteEnv, recorderModeEnv)
+	}
+
+	c, err := loadCassette(path, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Using cassette %s to %s GitHub API interactions", path, mode)
+	return &recorderTransport{transport: rt, mode: mode, cassette: c}, nil
+}
+
+// newRecorderTransportFromEnv wraps rt in a recorderTransport when one is
+// requested through the environment. A misconfigured recorder fails every
+// request rather than silently reaching the network.
+func newRecorderTransportFromEnv(rt http.RoundTripper) http.RoundTripper {
+	mode := os.Getenv(recorderModeEnv)
+	if mode == "" {
+		return rt
+	}
+
+	recorder, err := NewRecorderTransport(rt, mode, os.Getenv(recorderCassetteEnv))
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		return failingTransport{err: err}
+	}
+	return recorder
+}
+
+// failingTransport fails every request with err
+type failingTransport struct {
+	err error
+}
+
+func (t failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, t.err
+}
+
+// redactHeaders replaces the values of the headers in loggingRedactedHeaders,
+// and anything matching loggingRedactedPatterns in the others
+func redactHeaders(header http.Header) http.Header {
+	redacted := make(http.Header, len(header))
+	for name, values := range header {
+		if loggingRedactedHeaders[strings.ToLower(name)] {
+			redacted[name] = []string{redactedValue}
+			continue
+		}
+		redacted[name] = make([]string, len(values))
+		for i, value := range values {
+			redacted[name][i] = redactPatterns(value)
+		}
+	}
+	return redacted
+}
+
+// redactRecordedBody redacts a request or response body for a cassette like
+// the debug logs: the values of the JSON fields in loggingRedactedFields are
+// replaced, as well as anything matching loggingRedactedPatterns. This also
+// covers the fields differing on every run, such as secrets encrypted with a
+// random nonce, so that requests still match when replayed.
+func redactRecordedBody(body []byte) string {
+	return redactPatterns(string(redactJSON(body, loggingRedactedFields)))
+}
+
+// redactPatterns replaces anything matching loggingRedactedPatterns in s
+func redactPatterns(s string) string {
+	for _, pattern := range loggingRedactedPatterns {
+		s = pattern.ReplaceAllString(s, redactedValue)
+	}
+	return s
+}
+
+// redactJSON replaces the values of the given fields anywhere in a JSON
+// document. Bodies which are not JSON are returned unchanged.
+func redactJSON(body []byte, fields map[string]bool) []byte {
+	var document interface{}
+	if len(body) == 0 || json.Unmarshal(body, &document) != nil {
+		return body
+	}
+
+	redacted, err := json.Marshal(redactJSONValue(document, fields))
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+func redactJSONValue(value interface{}, fields map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if _, isString := field.(string); isString && fields[strings.ToLower(key)] {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactJSONValue(field, fields)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSONValue(item, fields)
+		}
+	}
+	return value
+}
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/go-github/v53/github"
 	"io"
@@ -9,8 +10,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/shurcooL/githubv4"
 )
 
 func TestEtagTransport(t *testing.T) {
@@ -271,6 +276,258 @@ func TestRateLimitTransport_abuseLimit_post_error(t *testing.T) {
 		t.Fatalf("Expected message %q, got: %q", expectedMessage, ghErr.Message)
 	}
 }
+func TestRateLimitTransport_retryAfter(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/test/blah",
+			ResponseBody: `{"message": "slow down"}`,
+			StatusCode:   429,
+			ResponseHeaders: map[string]string{
+				"Retry-After": "0.1",
+			},
+		},
+		{
+			ExpectedUri:  "/repos/test/blah",
+			ResponseBody: `{"id": 1234}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	httpClient := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport)}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	r, _, err := client.Repositories.Get(context.Background(), "test", "blah")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.GetID() != 1234 {
+		t.Fatalf("Expected ID to be 1234, got: %d", r.GetID())
+	}
+}
+
+func TestRateLimitTransport_cancelled(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/test/blah",
+			ResponseBody: `{"message": "slow down"}`,
+			StatusCode:   429,
+			ResponseHeaders: map[string]string{
+				"Retry-After": "60",
+			},
+		},
+	})
+	defer ts.Close()
+
+	httpClient := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport)}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := client.Repositories.Get(ctx, "test", "blah")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the deadline to be exceeded, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("Expected the rate limit wait to be cut short, waited %s", elapsed)
+	}
+}
+
+func TestRateLimitTransport_maxRetries(t *testing.T) {
+	limited := &mockResponse{
+		ExpectedUri:  "/repos/test/blah",
+		ResponseBody: `{"message": "slow down"}`,
+		StatusCode:   429,
+		ResponseHeaders: map[string]string{
+			"Retry-After": "0.01",
+		},
+	}
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{limited, limited, limited, {
+			ExpectedUri:  "/repos/test/blah",
+			ResponseBody: `{"id": 1234}`,
+			StatusCode:   200,
+		}})
+		defer ts.Close()
+
+		httpClient := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport, WithRateLimitRetries(2, time.Minute))}
+		client := github.NewClient(httpClient)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		_, resp, err := client.Repositories.Get(context.Background(), "test", "blah")
+		if err == nil {
+			t.Fatal("Expected 429 error, got nil")
+		}
+		if resp.StatusCode != 429 {
+			t.Fatalf("Expected status 429, got: %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("does not wait longer than the max wait", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{{
+			ExpectedUri:  "/repos/test/blah",
+			ResponseBody: `{"message": "slow down"}`,
+			StatusCode:   429,
+			ResponseHeaders: map[string]string{
+				"Retry-After": "60",
+			},
+		}})
+		defer ts.Close()
+
+		httpClient := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport, WithRateLimitRetries(10, time.Second))}
+		client := github.NewClient(httpClient)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		start := time.Now()
+		_, resp, err := client.Repositories.Get(context.Background(), "test", "blah")
+		if err == nil {
+			t.Fatal("Expected 429 error, got nil")
+		}
+		if resp.StatusCode != 429 {
+			t.Fatalf("Expected status 429, got: %d", resp.StatusCode)
+		}
+		if elapsed := time.Since(start); elapsed > 10*time.Second {
+			t.Fatalf("Expected not to wait for the rate limit, waited %s", elapsed)
+		}
+	})
+}
+
+func TestRateLimitTransport_graphQL(t *testing.T) {
+	var query struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+
+	t.Run("waits and retries after a RATE_LIMITED error", func(t *testing.T) {
+		resetAt := time.Now().Add(100 * time.Millisecond).Format(time.RFC3339Nano)
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/graphql",
+				ExpectedMethod: "POST",
+				ResponseBody: fmt.Sprintf(`{
+  "data": {"rateLimit": {"cost": 1, "remaining": 0, "resetAt": "%s"}},
+  "errors": [{"type": "RATE_LIMITED", "message": "API rate limit exceeded for user ID 1."}]
+}`, resetAt),
+				StatusCode: 200,
+			},
+			{
+				ExpectedUri:    "/graphql",
+				ExpectedMethod: "POST",
+				ResponseBody:   `{"data": {"viewer": {"login": "octocat"}}}`,
+				StatusCode:     200,
+			},
+		})
+		defer ts.Close()
+
+		rlt := NewRateLimitTransport(http.DefaultTransport)
+		client := githubv4.NewEnterpriseClient(ts.URL+"/graphql", &http.Client{Transport: rlt})
+
+		if err := client.Query(context.Background(), &query, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		if query.Viewer.Login != "octocat" {
+			t.Fatalf("Expected login to be octocat, got: %s", query.Viewer.Login)
+		}
+
+		if budget := rlt.budgets["graphql"]; budget.remaining != 0 || budget.reset.IsZero() {
+			t.Fatalf("Expected the GraphQL rate limit to be recorded, got: %+v", budget)
+		}
+	})
+
+	t.Run("waits and retries after a secondary rate limit", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/graphql",
+				ExpectedMethod: "POST",
+				ResponseBody: `{
+  "documentation_url": "https://docs.github.com/graphql/overview/rate-limits-and-node-limits-for-the-graphql-api#secondary-rate-limits",
+  "message": "You have exceeded a secondary rate limit. Please wait a few minutes before you try again."
+}`,
+				StatusCode: 403,
+				ResponseHeaders: map[string]string{
+					"Retry-After": "0.1",
+				},
+			},
+			{
+				ExpectedUri:    "/graphql",
+				ExpectedMethod: "POST",
+				ResponseBody:   `{"data": {"viewer": {"login": "octocat"}}}`,
+				StatusCode:     200,
+			},
+		})
+		defer ts.Close()
+
+		client := githubv4.NewEnterpriseClient(ts.URL+"/graphql", &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport)})
+
+		if err := client.Query(context.Background(), &query, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		if query.Viewer.Login != "octocat" {
+			t.Fatalf("Expected login to be octocat, got: %s", query.Viewer.Login)
+		}
+	})
+}
+
+func TestRateLimitTransport_budget(t *testing.T) {
+	rlt := NewRateLimitTransport(http.DefaultTransport, WithRateLimitBudget(100, 10))
+
+	req, _ := http.NewRequest("GET", "https://api.github.com/repos/test/blah", nil)
+	graphqlReq, _ := http.NewRequest("POST", "https://api.github.com/graphql", nil)
+
+	report := func(remaining string, reset time.Time, resource string) {
+		rlt.updateBudget(req, &http.Response{Header: http.Header{
+			"X-Ratelimit-Remaining": []string{remaining},
+			"X-Ratelimit-Reset":     []string{fmt.Sprintf("%d", reset.Unix())},
+			"X-Ratelimit-Resource":  []string{resource},
+		}})
+	}
+
+	if delay := rlt.budgetDelay(req); delay != 0 {
+		t.Fatalf("Expected no delay without a known budget, got: %s", delay)
+	}
+
+	reset := time.Now().Add(100 * time.Second)
+
+	report("4000", reset, "core")
+	if delay := rlt.budgetDelay(req); delay != 0 {
+		t.Fatalf("Expected no delay above the threshold, got: %s", delay)
+	}
+
+	report("60", reset, "core")
+	if delay := rlt.budgetDelay(req); delay < 1500*time.Millisecond || delay > 2*time.Second {
+		t.Fatalf("Expected the remaining requests to be spread over the window, got: %s", delay)
+	}
+
+	if delay := rlt.budgetDelay(graphqlReq); delay != 0 {
+		t.Fatalf("Expected the GraphQL budget to be tracked separately, got: %s", delay)
+	}
+
+	report("10", reset, "core")
+	if delay := rlt.budgetDelay(req); delay < 90*time.Second {
+		t.Fatalf("Expected to wait for the reset once the reserve is reached, got: %s", delay)
+	}
+
+	report("10", time.Now().Add(-time.Second), "core")
+	if delay := rlt.budgetDelay(req); delay != 0 {
+		t.Fatalf("Expected no delay once the window has reset, got: %s", delay)
+	}
+}
+
 func TestRateLimitTransport_smart_lock(t *testing.T) {
 	t.Run("With parallelRequests true it does not lock the rate limit transport", func(t *testing.T) {
 		rlt := NewRateLimitTransport(http.DefaultTransport, WithParallelRequests(true))
@@ -342,6 +599,370 @@ func TestRateLimitTransport_smart_lock(t *testing.T) {
 
 }
 
+func TestRetryTransport(t *testing.T) {
+	newClient := func(ts *httptest.Server, maxRetries int) *github.Client {
+		httpClient := &http.Client{Transport: NewRetryTransport(http.DefaultTransport,
+			WithMaxRetries(maxRetries), WithRetryBackoff(time.Millisecond, 10*time.Millisecond))}
+
+		client := github.NewClient(httpClient)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+		return client
+	}
+
+	t.Run("retries a read after transient server errors", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri: "/repos/test/blah",
+				StatusCode:  502,
+				ResponseHeaders: map[string]string{
+					"X-GitHub-Request-Id": "0001:0002",
+				},
+			},
+			{
+				ExpectedUri: "/repos/test/blah",
+				StatusCode:  504,
+			},
+			{
+				ExpectedUri:  "/repos/test/blah",
+				ResponseBody: `{"id": 1234}`,
+				StatusCode:   200,
+			},
+		})
+		defer ts.Close()
+
+		r, _, err := newClient(ts, 3).Repositories.Get(context.Background(), "test", "blah")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.GetID() != 1234 {
+			t.Fatalf("Expected ID to be 1234, got: %d", r.GetID())
+		}
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri: "/repos/test/blah",
+				StatusCode:  503,
+			},
+			{
+				ExpectedUri: "/repos/test/blah",
+				StatusCode:  503,
+			},
+			{
+				ExpectedUri:  "/repos/test/blah",
+				ResponseBody: `{"id": 1234}`,
+				StatusCode:   200,
+			},
+		})
+		defer ts.Close()
+
+		_, resp, err := newClient(ts, 1).Repositories.Get(context.Background(), "test", "blah")
+		if err == nil {
+			t.Fatal("Expected 503 error, got nil")
+		}
+
+		if resp.StatusCode != 503 {
+			t.Fatalf("Expected status 503, got: %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("retries a write which was not processed with the same body", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/orgs/tada/repos",
+				ExpectedMethod: "POST",
+				StatusCode:     503,
+				ResponseHeaders: map[string]string{
+					"Retry-After": "0",
+				},
+			},
+			{
+				ExpectedUri:    "/orgs/tada/repos",
+				ExpectedMethod: "POST",
+				ExpectedBody: []byte(`{"name":"radek-example-48"}
+`),
+				ResponseBody: `{"id": 1234}`,
+				StatusCode:   201,
+			},
+		})
+		defer ts.Close()
+
+		r, _, err := newClient(ts, 3).Repositories.Create(context.Background(), "tada", &github.Repository{
+			Name: github.String("radek-example-48"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.GetID() != 1234 {
+			t.Fatalf("Expected ID to be 1234, got: %d", r.GetID())
+		}
+	})
+
+	for _, status := range []int{502, 503, 504} {
+		t.Run(fmt.Sprintf("does not retry a write which may have been processed with %d", status), func(t *testing.T) {
+			ts := githubApiMock([]*mockResponse{
+				{
+					ExpectedUri:    "/orgs/tada/repos",
+					ExpectedMethod: "POST",
+					StatusCode:     status,
+				},
+				{
+					ExpectedUri:    "/orgs/tada/repos",
+					ExpectedMethod: "POST",
+					ResponseBody:   `{"id": 1234}`,
+					StatusCode:     201,
+				},
+			})
+			defer ts.Close()
+
+			_, resp, err := newClient(ts, 3).Repositories.Create(context.Background(), "tada", &github.Repository{
+				Name: github.String("radek-example-48"),
+			})
+			if err == nil {
+				t.Fatalf("Expected %d error, got nil", status)
+			}
+
+			if resp.StatusCode != status {
+				t.Fatalf("Expected status %d, got: %d", status, resp.StatusCode)
+			}
+		})
+	}
+
+	t.Run("treats GraphQL queries as idempotent", func(t *testing.T) {
+		query, _ := http.NewRequest("POST", "https://api.github.com/graphql", strings.NewReader(`{"query":"query{viewer{login}}"}`))
+		if !isIdempotentRequest(query) {
+			t.Fatal("Expected a GraphQL query to be idempotent")
+		}
+
+		mutation, _ := http.NewRequest("POST", "https://api.github.com/graphql", strings.NewReader(`{"query":"mutation($input:AddStarInput!){addStar(input:$input){clientMutationId}}"}`))
+		if isIdempotentRequest(mutation) {
+			t.Fatal("Expected a GraphQL mutation not to be idempotent")
+		}
+	})
+}
+
+func TestRetryTransport_rateLimited(t *testing.T) {
+	var attempts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "0.01")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"message": "slow down"}`))
+	}))
+	defer ts.Close()
+
+	// The transports are chained as in the provider configuration
+	transport := NewRateLimitTransport(http.DefaultTransport, WithRateLimitRetries(2, time.Minute))
+	httpClient := &http.Client{Transport: NewRetryTransport(transport,
+		WithMaxRetries(3), WithRetryBackoff(time.Millisecond, 10*time.Millisecond))}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	_, resp, err := client.Repositories.Get(context.Background(), "test", "blah")
+	if err == nil || resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected a 429 error, got: %v", err)
+	}
+
+	// Only the rate limit transport retries rate limited requests
+	if n := atomic.LoadInt32(&attempts); n != 3 {
+		t.Fatalf("Expected 3 attempts, got: %d", n)
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	rt := NewRetryTransport(http.DefaultTransport, WithRetryBackoff(time.Second, 5*time.Second), WithRetryJitter(false))
+
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if delay := rt.backoff(attempt, nil); delay != expected {
+			t.Fatalf("Expected delay %s for attempt %d, got: %s", expected, attempt, delay)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"60"}}}
+	if delay := rt.backoff(0, resp); delay != time.Minute {
+		t.Fatalf("Expected Retry-After to be honoured, got: %s", delay)
+	}
+
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"1.5"}}}
+	if delay := rt.backoff(0, resp); delay != 1500*time.Millisecond {
+		t.Fatalf("Expected a fractional Retry-After to be honoured, got: %s", delay)
+	}
+
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)}}}
+	if delay := rt.backoff(0, resp); delay < 59*time.Minute {
+		t.Fatalf("Expected a Retry-After date to be honoured, got: %s", delay)
+	}
+
+	rt = NewRetryTransport(http.DefaultTransport, WithRetryBackoff(time.Second, 5*time.Second), WithRetryJitter(true))
+	for i := 0; i < 100; i++ {
+		if delay := rt.backoff(1, nil); delay < time.Second || delay > 2*time.Second {
+			t.Fatalf("Expected jittered delay between 1s and 2s, got: %s", delay)
+		}
+	}
+}
+
+func TestRateLimitTransport_concurrentReads(t *testing.T) {
+	var inFlight, maxInFlight int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		<-release
+		w.WriteHeader(200)
+	}))
+	defer ts.Close()
+
+	rlt := NewRateLimitTransport(http.DefaultTransport, WithMaxConcurrentReads(2), WithWriteDelay(0))
+	client := &http.Client{Transport: rlt}
+
+	send := func(method string) chan error {
+		done := make(chan error, 1)
+		go func() {
+			req, _ := http.NewRequest(method, ts.URL+"/repos/test/blah", nil)
+			resp, err := client.Do(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+			done <- err
+		}()
+		return done
+	}
+
+	reads := []chan error{send("GET"), send("GET"), send("GET")}
+	time.Sleep(100 * time.Millisecond)
+
+	if n := atomic.LoadInt32(&inFlight); n != 2 {
+		t.Fatalf("Expected 2 reads in flight, got: %d", n)
+	}
+
+	write := send("DELETE")
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
+	for _, done := range append(reads, write) {
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := atomic.LoadInt32(&maxInFlight); n != 2 {
+		t.Fatalf("Expected at most 2 requests in flight, got: %d", n)
+	}
+}
+
+func TestRateLimitTransport_writeLock(t *testing.T) {
+	t.Run("A write waits for reads in flight", func(t *testing.T) {
+		rlt := NewRateLimitTransport(http.DefaultTransport, WithMaxConcurrentReads(2))
+
+		isSuccess := make(chan bool)
+		go func() {
+			rlt.smartRLock(true)
+			rlt.smartLock(true)
+			isSuccess <- true
+		}()
+		select {
+		case <-isSuccess:
+			t.Fatalf("Expected get stuck waiting but it acquired the lock successfully")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("Reads share the lock up to the limit", func(t *testing.T) {
+		rlt := NewRateLimitTransport(http.DefaultTransport, WithMaxConcurrentReads(2))
+
+		isSuccess := make(chan bool)
+		go func() {
+			rlt.smartRLock(true)
+			rlt.smartRLock(true)
+			isSuccess <- true
+			rlt.smartRLock(true)
+			isSuccess <- true
+		}()
+		select {
+		case <-isSuccess:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatalf("Expected to succeed instantly, waited 100 milliseconds unsuccessfully")
+		}
+		select {
+		case <-isSuccess:
+			t.Fatalf("Expected get stuck waiting but it acquired a third read slot")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
+
+func TestReadOnlyTransport(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/test/blah",
+			ExpectedMethod: "GET",
+			ResponseBody:   `{"id": 1234}`,
+			StatusCode:     200,
+		},
+		{
+			ExpectedUri:    "/graphql",
+			ExpectedMethod: "POST",
+			ResponseBody:   `{"data": {"viewer": {"login": "octocat"}}}`,
+			StatusCode:     200,
+		},
+	})
+	defer ts.Close()
+
+	httpClient := &http.Client{Transport: NewReadOnlyTransport(http.DefaultTransport)}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	ctx := context.WithValue(context.Background(), ctxId, "test/blah")
+	if _, _, err := client.Repositories.Get(ctx, "test", "blah"); err != nil {
+		t.Fatalf("Expected reads to be allowed, got: %s", err)
+	}
+
+	v4client := githubv4.NewEnterpriseClient(ts.URL+"/graphql", httpClient)
+	var query struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	if err := v4client.Query(ctx, &query, nil); err != nil {
+		t.Fatalf("Expected GraphQL queries to be allowed, got: %s", err)
+	}
+
+	resourceCtx := context.WithValue(ctx, ctxResourceType, "github_repository")
+	_, err := client.Repositories.Delete(resourceCtx, "test", "blah")
+	if err == nil || !strings.Contains(err.Error(), "read_only") || !strings.Contains(err.Error(), "github_repository test/blah") {
+		t.Fatalf("Expected a read_only error naming the resource, got: %v", err)
+	}
+
+	_, err = client.Repositories.Delete(context.Background(), "test", "blah")
+	if err == nil || !strings.Contains(err.Error(), "read_only") || !strings.Contains(err.Error(), "DELETE /repos/test/blah") {
+		t.Fatalf("Expected a read_only error naming the request, got: %v", err)
+	}
+
+	var mutation struct {
+		AddStar struct {
+			ClientMutationID githubv4.String
+		} `graphql:"addStar(input: $input)"`
+	}
+	err = v4client.Mutate(ctx, &mutation, githubv4.AddStarInput{StarrableID: "R_1"}, nil)
+	if err == nil || !strings.Contains(err.Error(), "GraphQL mutation (POST /graphql)") {
+		t.Fatalf("Expected GraphQL mutations to be rejected, got: %v", err)
+	}
+}
+
 type mockResponse struct {
 	ExpectedUri     string
 	ExpectedMethod  string
@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v53/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -19,6 +20,10 @@ import (
 const (
 	// https://developer.github.com/guides/traversing-with-pagination/#basics-of-pagination
 	maxPerPage = 100
+
+	// defaultOperationTimeout is long enough to wait out a full primary rate
+	// limit window, which is what operations would do before timeouts existed
+	defaultOperationTimeout = time.Hour
 )
 
 func checkOrganization(meta interface{}) error {
@@ -29,6 +34,101 @@ func checkOrganization(meta interface{}) error {
 	return nil
 }
 
+// stopContext returns the context cancelled when Terraform interrupts the
+// provider, e.g. after a Ctrl-C during apply.
+func stopContext(meta interface{}) context.Context {
+	ctx := context.Background()
+
+	owner, ok := meta.(*Owner)
+	if !ok {
+		return ctx
+	}
+	if owner.StopContext != nil {
+		ctx = owner.StopContext
+	}
+	if owner.resourceType != "" {
+		ctx = context.WithValue(ctx, ctxResourceType, owner.resourceType)
+	}
+	return ctx
+}
+
+// operationContext returns the context for the API calls of a CRUD function.
+// It is cancelled when Terraform is interrupted or when the timeout configured
+// for the operation in the timeouts block of the resource expires.
+func operationContext(d *schema.ResourceData, meta interface{}, operation string) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(stopContext(meta), d.Timeout(operation))
+}
+
+// createOrUpdateOperation returns the timeout key for functions serving as
+// both the Create and the Update function of a resource.
+func createOrUpdateOperation(d *schema.ResourceData) string {
+	if d.IsNewResource() {
+		return schema.TimeoutCreate
+	}
+	return schema.TimeoutUpdate
+}
+
+// setDefaultTimeouts lets the timeouts block of a resource configure every
+// operation it implements, unless the resource declares its own timeouts.
+func setDefaultTimeouts(r *schema.Resource) {
+	if r.Timeouts != nil {
+		return
+	}
+
+	r.Timeouts = &schema.ResourceTimeout{Read: schema.DefaultTimeout(defaultOperationTimeout)}
+	if r.Create != nil {
+		r.Timeouts.Create = schema.DefaultTimeout(defaultOperationTimeout)
+	}
+	if r.Update != nil {
+		r.Timeouts.Update = schema.DefaultTimeout(defaultOperationTimeout)
+	}
+	if r.Delete != nil {
+		r.Timeouts.Delete = schema.DefaultTimeout(defaultOperationTimeout)
+	}
+}
+
+// setResourceType makes the functions of r pass on a copy of the provider meta
+// naming the resource type, so that the API calls they make can be attributed
+// to it in errors and logs.
+func setResourceType(name string, r *schema.Resource) {
+	withType := func(meta interface{}) interface{} {
+		owner, ok := meta.(*Owner)
+		if !ok {
+			return meta
+		}
+		typed := *owner
+		typed.resourceType = name
+		return &typed
+	}
+
+	if create := r.Create; create != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return create(d, withType(meta))
+		}
+	}
+	if read := r.Read; read != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			return read(d, withType(meta))
+		}
+	}
+	if update := r.Update; update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return update(d, withType(meta))
+		}
+	}
+	if del := r.Delete; del != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			return del(d, withType(meta))
+		}
+	}
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			return state(d, withType(meta))
+		}
+	}
+}
+
 func caseInsensitive() schema.SchemaDiffSuppressFunc {
 	return func(k, old, new string, d *schema.ResourceData) bool {
 		return strings.EqualFold(old, new)
@@ -145,10 +245,9 @@ func splitRepoFilePath(path string) (string, string) {
 	return parts[0], strings.Join(parts[1:], "/")
 }
 
-func getTeamID(teamIDString string, meta interface{}) (int64, error) {
+func getTeamID(ctx context.Context, teamIDString string, meta interface{}) (int64, error) {
 	// Given a string that is either a team id or team slug, return the
 	// id of the team it is referring to.
-	ctx := context.Background()
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
 
@@ -165,10 +264,9 @@ func getTeamID(teamIDString string, meta interface{}) (int64, error) {
 	return team.GetID(), nil
 }
 
-func getTeamSlug(teamIDString string, meta interface{}) (string, error) {
+func getTeamSlug(ctx context.Context, teamIDString string, meta interface{}) (string, error) {
 	// Given a string that is either a team id or team slug, return the
 	// team slug it is referring to.
-	ctx := context.Background()
 	client := meta.(*Owner).v3client
 	orgName := meta.(*Owner).name
 	orgId := meta.(*Owner).id
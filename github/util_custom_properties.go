@@ -0,0 +1,281 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v53/github"
+)
+
+// The custom properties API is not part of the go-github release the provider
+// uses, so its types are declared here.
+
+// customProperty is a custom property of the repositories of an
+// organization. Its default value is a string or, for multi_select
+// properties, an array of strings.
+type customProperty struct {
+	PropertyName  string          `json:"property_name,omitempty"`
+	ValueType     string          `json:"value_type"`
+	Required      bool            `json:"required"`
+	DefaultValue  json.RawMessage `json:"default_value"`
+	Description   string          `json:"description,omitempty"`
+	AllowedValues []string        `json:"allowed_values,omitempty"`
+}
+
+// customPropertyValue is the value of a custom property of a repository
+type customPropertyValue struct {
+	PropertyName string          `json:"property_name"`
+	Value        json.RawMessage `json:"value"`
+}
+
+// repositoryCustomPropertyValues are the custom property values of a
+// repository as the organization endpoint lists them
+type repositoryCustomPropertyValues struct {
+	RepositoryName string                 `json:"repository_name"`
+	Properties     []*customPropertyValue `json:"properties"`
+}
+
+// customPropertyValueTypes are the types of the values of custom properties
+var customPropertyValueTypes = []string{"string", "single_select", "multi_select", "true_false"}
+
+// encodeCustomPropertyValue returns values as the API expects the value of a
+// property of valueType: an array for multi_select properties, otherwise a
+// single string, or null to unset it
+func encodeCustomPropertyValue(valueType string, values []string) (json.RawMessage, error) {
+	if valueType == "multi_select" {
+		if values == nil {
+			values = []string{}
+		}
+		return json.Marshal(values)
+	}
+	switch len(values) {
+	case 0:
+		return json.RawMessage("null"), nil
+	case 1:
+		return json.Marshal(values[0])
+	default:
+		return nil, fmt.Errorf("a %s custom property takes a single value, got %d", valueType, len(values))
+	}
+}
+
+// decodeCustomPropertyValue returns the values of a property value, which is
+// null, a string or an array of strings
+func decodeCustomPropertyValue(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var values []string
+	if raw[0] == '[' {
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return nil, err
+		}
+		return values, nil
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return []string{value}, nil
+}
+
+// customPropertyRequest sends a request to path, which is relative to the API
+// root, decoding the response into result unless it is nil
+func customPropertyRequest(ctx context.Context, client *github.Client, method, path string, body, result interface{}) (*github.Response, error) {
+	req, err := client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(ctx, req, result)
+}
+
+func customPropertySchemaPath(org, name string) string {
+	return fmt.Sprintf("orgs/%s/properties/schema/%s", org, url.PathEscape(name))
+}
+
+func repositoryCustomPropertyValuesPath(owner, repoName string) string {
+	return fmt.Sprintf("repos/%s/%s/properties/values", owner, repoName)
+}
+
+// getRepositoryCustomPropertyValues reads the custom property values of a
+// single repository
+func getRepositoryCustomPropertyValues(ctx context.Context, client *github.Client, owner, repoName string) ([]*customPropertyValue, error) {
+	var values []*customPropertyValue
+	_, err := customPropertyRequest(ctx, client, "GET", repositoryCustomPropertyValuesPath(owner, repoName), nil, &values)
+	return values, err
+}
+
+// listOrganizationCustomPropertyValues lists the custom property values of
+// every repository of an organization
+func listOrganizationCustomPropertyValues(ctx context.Context, client *github.Client, org string) ([]*repositoryCustomPropertyValues, error) {
+	query := url.Values{}
+	query.Set("per_page", strconv.Itoa(maxPerPage))
+
+	var all []*repositoryCustomPropertyValues
+	for page := 1; page != 0; {
+		query.Set("page", strconv.Itoa(page))
+
+		var values []*repositoryCustomPropertyValues
+		resp, err := customPropertyRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/properties/values?%s", org, query.Encode()), nil, &values)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, values...)
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// flattenCustomPropertyValues returns property values as a map from property
+// names to values, joining the values of multi_select properties with commas
+func flattenCustomPropertyValues(values []*customPropertyValue) (map[string]interface{}, error) {
+	properties := make(map[string]interface{})
+	for _, v := range values {
+		decoded, err := decodeCustomPropertyValue(v.Value)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode the value of custom property %s: %s", v.PropertyName, err)
+		}
+		if decoded == nil {
+			continue
+		}
+		properties[v.PropertyName] = strings.Join(decoded, ",")
+	}
+	return properties, nil
+}
+
+// customPropertyCache remembers the custom property values of the
+// repositories of each organization, so that reading them for many
+// repositories takes a single listing per organization and provider. The
+// values of an organization are nil when they could not be listed.
+type customPropertyCache struct {
+	orgs map[string]map[string][]*customPropertyValue
+	// pending are the listings in flight, which other readers of the same
+	// organization wait for rather than holding the lock across the listing
+	pending map[string]*customPropertyListing
+	m       sync.Mutex
+}
+
+type customPropertyListing struct {
+	done  chan struct{}
+	repos map[string][]*customPropertyValue
+	err   error
+}
+
+func newCustomPropertyCache() *customPropertyCache {
+	return &customPropertyCache{
+		orgs:    make(map[string]map[string][]*customPropertyValue),
+		pending: make(map[string]*customPropertyListing),
+	}
+}
+
+// forget drops the cached values of org after they were changed
+func (c *customPropertyCache) forget(org string) {
+	if c == nil {
+		return
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	key := strings.ToLower(org)
+	delete(c.orgs, key)
+	// A listing in flight may predate the change, so it is not cached
+	delete(c.pending, key)
+}
+
+// repositoryCustomProperties returns the custom property values of a
+// repository of an organization, listing those of the whole organization the
+// first time. It falls back to the endpoint of the repository when the
+// organization values cannot be listed, e.g. without the permission to.
+func (o *Owner) repositoryCustomProperties(ctx context.Context, org, repoName string) ([]*customPropertyValue, error) {
+	if o.customProperties != nil {
+		repos, err := o.customProperties.organization(ctx, o.v3client, org)
+		if err != nil {
+			return nil, err
+		}
+		// Repositories created since the listing are read on their own
+		if values, ok := repos[strings.ToLower(repoName)]; ok {
+			return values, nil
+		}
+	}
+	return getRepositoryCustomPropertyValues(ctx, o.v3client, org, repoName)
+}
+
+// organization returns the custom property values of the repositories of
+// org by lowercase name, listing them unless they are cached or being listed
+func (c *customPropertyCache) organization(ctx context.Context, client *github.Client, org string) (map[string][]*customPropertyValue, error) {
+	key := strings.ToLower(org)
+	c.m.Lock()
+	if repos, ok := c.orgs[key]; ok {
+		c.m.Unlock()
+		return repos, nil
+	}
+	if listing, ok := c.pending[key]; ok {
+		c.m.Unlock()
+		select {
+		case <-listing.done:
+			return listing.repos, listing.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	listing := &customPropertyListing{done: make(chan struct{})}
+	c.pending[key] = listing
+	c.m.Unlock()
+
+	listing.repos, listing.err = listCustomPropertyRepositories(ctx, client, org)
+
+	c.m.Lock()
+	if c.pending[key] == listing {
+		delete(c.pending, key)
+		if listing.err == nil {
+			// Failed listings are tried again by the next reader
+			c.orgs[key] = listing.repos
+		}
+	}
+	c.m.Unlock()
+	close(listing.done)
+
+	return listing.repos, listing.err
+}
+
+// listCustomPropertyRepositories lists the custom property values of the
+// repositories of org by lowercase name, or nil when they cannot be listed
+func listCustomPropertyRepositories(ctx context.Context, client *github.Client, org string) (map[string][]*customPropertyValue, error) {
+	values, err := listOrganizationCustomPropertyValues(ctx, client, org)
+	if err != nil {
+		if !customPropertiesUnavailable(err) {
+			return nil, err
+		}
+		log.Printf("[DEBUG] Unable to list the custom property values of organization %s, reading them per repository: %s", org, err)
+		return nil, nil
+	}
+
+	repos := make(map[string][]*customPropertyValue, len(values))
+	for _, v := range values {
+		repos[strings.ToLower(v.RepositoryName)] = v.Properties
+	}
+	return repos, nil
+}
+
+// customPropertiesUnavailable tells whether err means custom properties
+// cannot be read, because the server does not support them or the
+// credentials do not allow it
+func customPropertiesUnavailable(err error) bool {
+	ghErr, ok := err.(*github.ErrorResponse)
+	if !ok {
+		return false
+	}
+	switch ghErr.Response.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ghesVersionTimeout bounds the detection of the GitHub Enterprise Server
+// version when the provider is configured
+const ghesVersionTimeout = 10 * time.Second
+
+// getGHESVersion reads the version of a GitHub Enterprise Server from the
+// installed_version field of its meta endpoint, which github.com does not
+// return.
+func getGHESVersion(ctx context.Context, client *github.Client) (*version.Version, error) {
+	req, err := client.NewRequest("GET", "meta", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var meta struct {
+		InstalledVersion string `json:"installed_version"`
+	}
+	if _, err := client.Do(ctx, req, &meta); err != nil {
+		return nil, err
+	}
+
+	if meta.InstalledVersion == "" {
+		return nil, fmt.Errorf("no installed_version in the meta endpoint response")
+	}
+	return version.NewVersion(meta.InstalledVersion)
+}
+
+// requireGHESVersion returns an error when the provider is connected to a
+// GitHub Enterprise Server older than minimum, naming the feature which is not
+// available. It never fails against github.com or when the server version
+// could not be detected.
+func requireGHESVersion(meta interface{}, minimum, feature string) error {
+	owner, ok := meta.(*Owner)
+	if !ok || owner.ghesVersion == nil {
+		return nil
+	}
+
+	if owner.ghesVersion.LessThan(version.Must(version.NewVersion(minimum))) {
+		return fmt.Errorf("%s requires GHES >= %s, but the server runs GHES %s", feature, minimum, owner.ghesVersion.Original())
+	}
+	return nil
+}
+
+// requireGHESVersionDiff fails the plan of a resource which is not available
+// on GitHub Enterprise Server releases older than minimum.
+func requireGHESVersionDiff(minimum, feature string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		return requireGHESVersion(meta, minimum, feature)
+	}
+}
+
+// configureGHESVersion records the version of the GitHub Enterprise Server the
+// provider is connected to. Failing to detect it only disables the version
+// checks of resources.
+func (c *Config) configureGHESVersion(owner *Owner) {
+	if !c.IsGHES() {
+		return
+	}
+
+	// A server which does not answer must not block the configuration
+	ctx, cancel := context.WithTimeout(stopContext(owner), ghesVersionTimeout)
+	defer cancel()
+
+	v, err := getGHESVersion(ctx, owner.v3client)
+	if err != nil {
+		log.Printf("[WARN] Unable to detect the GitHub Enterprise Server version, skipping version checks: %s", err)
+		return
+	}
+
+	log.Printf("[INFO] Connected to GitHub Enterprise Server %s", v.Original())
+	owner.ghesVersion = v
+}
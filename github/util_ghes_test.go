@@ -0,0 +1,61 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/go-version"
+)
+
+func TestGetGHESVersion(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/api/v3/meta",
+			ResponseBody: `{"verifiable_password_authentication": true, "installed_version": "3.6.4"}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/api/v3/meta",
+			ResponseBody: `{"verifiable_password_authentication": true}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(http.DefaultClient)
+	u, _ := url.Parse(ts.URL + "/api/v3/")
+	client.BaseURL = u
+
+	v, err := getGHESVersion(context.Background(), client)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if v.String() != "3.6.4" {
+		t.Fatalf("Expected version 3.6.4, got: %s", v)
+	}
+
+	if _, err := getGHESVersion(context.Background(), client); err == nil {
+		t.Fatal("Expected an error without installed_version, got nil")
+	}
+}
+
+func TestRequireGHESVersion(t *testing.T) {
+	if err := requireGHESVersion(&Owner{}, "3.7", "feature"); err != nil {
+		t.Fatalf("Expected no error against github.com, got: %s", err)
+	}
+
+	owner := &Owner{ghesVersion: version.Must(version.NewVersion("3.6.4"))}
+
+	if err := requireGHESVersion(owner, "3.6", "feature"); err != nil {
+		t.Fatalf("Expected no error on a recent enough server, got: %s", err)
+	}
+
+	err := requireGHESVersion(owner, "3.7", "feature")
+	if err == nil || !strings.Contains(err.Error(), "requires GHES >= 3.7") || !strings.Contains(err.Error(), "3.6.4") {
+		t.Fatalf("Expected an error naming both versions, got: %v", err)
+	}
+}
@@ -0,0 +1,319 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ownerIDSeparator separates the owner from the ID of a resource to import
+// for another owner than the one of the provider, e.g. `other-org@my-repo`
+const ownerIDSeparator = "@"
+
+// ownerImportIDRegexp matches the owner prefix of an import ID. GitHub logins
+// only contain alphanumeric characters and hyphens.
+var ownerImportIDRegexp = regexp.MustCompile(`^([a-zA-Z0-9-]+)` + ownerIDSeparator + `(.+)$`)
+
+// ownerOverrideSupported lists the resources and data sources which belong to
+// an organization or a repository and get an owner argument. Resources which
+// do not, such as user level resources, or which take an owner of their own,
+// are left out, and new resources have to be added here explicitly.
+var ownerOverrideSupported = map[string]bool{
+	"github_actions_environment_secret":                                     true,
+	"github_actions_environment_variable":                                   true,
+	"github_actions_organization_oidc_subject_claim_customization_template": true,
+	"github_actions_organization_permissions":                               true,
+	"github_actions_organization_secret":                                    true,
+	"github_actions_organization_secret_repositories":                       true,
+	"github_actions_organization_variable":                                  true,
+	"github_actions_repository_access_level":                                true,
+	"github_actions_repository_oidc_subject_claim_customization_template":   true,
+	"github_actions_repository_permissions":                                 true,
+	"github_actions_runner_group":                                           true,
+	"github_actions_secret":                                                 true,
+	"github_actions_variable":                                               true,
+	"github_app_installation_repositories":                                  true,
+	"github_app_installation_repository":                                    true,
+	"github_branch":                                                         true,
+	"github_branch_default":                                                 true,
+	"github_branch_protection":                                              true,
+	"github_branch_protection_v3":                                           true,
+	"github_codespaces_organization_secret":                                 true,
+	"github_codespaces_secret":                                              true,
+	"github_dependabot_organization_secret":                                 true,
+	"github_dependabot_organization_secret_repositories":                    true,
+	"github_dependabot_secret":                                              true,
+	"github_emu_group_mapping":                                              true,
+	"github_issue":                                                          true,
+	"github_issue_label":                                                    true,
+	"github_membership":                                                     true,
+	"github_organization_block":                                             true,
+	"github_organization_custom_property":                                   true,
+	"github_organization_custom_role":                                       true,
+	"github_organization_project":                                           true,
+	"github_organization_ruleset":                                           true,
+	"github_organization_security_manager":                                  true,
+	"github_organization_settings":                                          true,
+	"github_organization_webhook":                                           true,
+	"github_project_card":                                                   true,
+	"github_project_column":                                                 true,
+	"github_project_v2":                                                     true,
+	"github_project_v2_field":                                               true,
+	"github_project_v2_item":                                                true,
+	"github_release":                                                        true,
+	"github_repository":                                                     true,
+	"github_repository_autolink_reference":                                  true,
+	"github_repository_collaborator":                                        true,
+	"github_repository_collaborators":                                       true,
+	"github_repository_custom_property":                                     true,
+	"github_repository_deploy_key":                                          true,
+	"github_repository_deployment_branch_policy":                            true,
+	"github_repository_environment":                                         true,
+	"github_repository_environment_deployment_policy":                       true,
+	"github_repository_file":                                                true,
+	"github_repository_project":                                             true,
+	"github_repository_ruleset":                                             true,
+	"github_repository_tag_protection":                                      true,
+	"github_repository_webhook":                                             true,
+	"github_team":                                                           true,
+	"github_team_members":                                                   true,
+	"github_team_membership":                                                true,
+	"github_team_repository":                                                true,
+	"github_team_settings":                                                  true,
+	"github_team_sync_group_mapping":                                        true,
+	"data.github_actions_environment_secrets":                               true,
+	"data.github_actions_environment_variables":                             true,
+	"data.github_actions_organization_oidc_subject_claim_customization_template": true,
+	"data.github_actions_organization_public_key":                                true,
+	"data.github_actions_organization_registration_token":                        true,
+	"data.github_actions_organization_secrets":                                   true,
+	"data.github_actions_organization_variables":                                 true,
+	"data.github_actions_public_key":                                             true,
+	"data.github_actions_registration_token":                                     true,
+	"data.github_actions_repository_oidc_subject_claim_customization_template":   true,
+	"data.github_actions_secrets":                                                true,
+	"data.github_actions_variables":                                              true,
+	"data.github_branch":                                                         true,
+	"data.github_branch_protection_rules":                                        true,
+	"data.github_branch_rules":                                                   true,
+	"data.github_codespaces_organization_public_key":                             true,
+	"data.github_codespaces_organization_secrets":                                true,
+	"data.github_codespaces_public_key":                                          true,
+	"data.github_codespaces_secrets":                                             true,
+	"data.github_dependabot_organization_public_key":                             true,
+	"data.github_dependabot_organization_secrets":                                true,
+	"data.github_dependabot_public_key":                                          true,
+	"data.github_dependabot_secrets":                                             true,
+	"data.github_external_groups":                                                true,
+	"data.github_issue_labels":                                                   true,
+	"data.github_membership":                                                     true,
+	"data.github_organization_custom_role":                                       true,
+	"data.github_organization_external_identities":                               true,
+	"data.github_organization_ip_allow_list":                                     true,
+	"data.github_organization_rule_suites":                                       true,
+	"data.github_organization_rulesets":                                          true,
+	"data.github_organization_team_sync_groups":                                  true,
+	"data.github_organization_teams":                                             true,
+	"data.github_organization_webhooks":                                          true,
+	"data.github_project_v2":                                                     true,
+	"data.github_repository":                                                     true,
+	"data.github_repository_autolink_references":                                 true,
+	"data.github_repository_branches":                                            true,
+	"data.github_repository_deploy_keys":                                         true,
+	"data.github_repository_deployment_branch_policies":                          true,
+	"data.github_repository_environments":                                        true,
+	"data.github_repository_file":                                                true,
+	"data.github_repository_rule_suites":                                         true,
+	"data.github_repository_rulesets":                                            true,
+	"data.github_repository_teams":                                               true,
+	"data.github_repository_webhooks":                                            true,
+	"data.github_team":                                                           true,
+	"data.github_tree":                                                           true,
+}
+
+// ownerInfo is what the provider knows about an owner other than its own
+type ownerInfo struct {
+	id             int64
+	isOrganization bool
+}
+
+// ownerCache remembers the owners resources were managed for, so that each of
+// them is only looked up once per provider.
+type ownerCache struct {
+	owners map[string]ownerInfo
+	// pending are the lookups in flight, which other callers wait for rather
+	// than holding the lock across the request
+	pending map[string]*ownerLookup
+	m       sync.Mutex
+}
+
+type ownerLookup struct {
+	done chan struct{}
+	info ownerInfo
+	err  error
+}
+
+func newOwnerCache() *ownerCache {
+	return &ownerCache{
+		owners:  make(map[string]ownerInfo),
+		pending: make(map[string]*ownerLookup),
+	}
+}
+
+// forOwner returns the provider meta to use for name: the meta of the
+// provider itself when name is empty or the provider owner, otherwise a copy
+// sharing its clients but resolving everything against name.
+func (o *Owner) forOwner(name string) (*Owner, error) {
+	if name == "" || strings.EqualFold(name, o.name) {
+		return o, nil
+	}
+
+	info, err := o.lookupOwner(name)
+	if err != nil {
+		return nil, err
+	}
+
+	override := *o
+	override.name = name
+	override.id = info.id
+	override.IsOrganization = info.isOrganization
+	return &override, nil
+}
+
+func (o *Owner) lookupOwner(name string) (ownerInfo, error) {
+	c := o.owners
+	if c == nil {
+		return o.fetchOwner(name)
+	}
+
+	key := strings.ToLower(name)
+	c.m.Lock()
+	if info, ok := c.owners[key]; ok {
+		c.m.Unlock()
+		return info, nil
+	}
+	if lookup, ok := c.pending[key]; ok {
+		c.m.Unlock()
+		<-lookup.done
+		return lookup.info, lookup.err
+	}
+	lookup := &ownerLookup{done: make(chan struct{})}
+	c.pending[key] = lookup
+	c.m.Unlock()
+
+	lookup.info, lookup.err = o.fetchOwner(name)
+
+	c.m.Lock()
+	delete(c.pending, key)
+	if lookup.err == nil {
+		// Failed lookups are tried again by the next caller
+		c.owners[key] = lookup.info
+	}
+	c.m.Unlock()
+	close(lookup.done)
+
+	return lookup.info, lookup.err
+}
+
+func (o *Owner) fetchOwner(name string) (ownerInfo, error) {
+	var info ownerInfo
+	org, resp, err := o.v3client.Organizations.Get(stopContext(o), name)
+	if err != nil {
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return info, fmt.Errorf("unable to look up owner %s: %s", name, err)
+		}
+		// Not an organization, so a user
+	} else {
+		info.id = org.GetID()
+		info.isOrganization = true
+	}
+	return info, nil
+}
+
+// ownerOverrideSchema is the owner argument added by setOwnerOverride
+func ownerOverrideSchema(isDataSource bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    !isDataSource,
+		Description: "The owner to manage this resource for. If not provided, the provider's default owner is used.",
+	}
+}
+
+// setOwnerOverride adds an optional owner argument to r and makes its
+// functions act on that owner instead of the provider owner when it is set.
+// The ID of a resource managed for another owner keeps its usual format, as
+// other resources take it as an argument and parse it; the owner is kept in
+// the owner attribute. Only import IDs may be prefixed with the owner,
+// separated by ownerIDSeparator.
+func setOwnerOverride(r *schema.Resource, isDataSource bool) {
+	if _, ok := r.Schema["owner"]; ok {
+		// The resource handles an owner of its own
+		return
+	}
+
+	r.Schema["owner"] = ownerOverrideSchema(isDataSource)
+
+	withOwner := func(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+		owner, ok := meta.(*Owner)
+		if !ok {
+			return meta, nil
+		}
+		return owner.forOwner(d.Get("owner").(string))
+	}
+
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		return func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := withOwner(d, meta)
+			if err != nil {
+				return err
+			}
+			return f(d, meta)
+		}
+	}
+
+	if create := r.Create; create != nil {
+		r.Create = wrap(create)
+	}
+	if read := r.Read; read != nil {
+		r.Read = wrap(read)
+	}
+	if update := r.Update; update != nil {
+		r.Update = wrap(update)
+	}
+	if del := r.Delete; del != nil {
+		r.Delete = wrap(del)
+	}
+
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			match := ownerImportIDRegexp.FindStringSubmatch(d.Id())
+			if match == nil {
+				return state(d, meta)
+			}
+
+			d.SetId(match[2])
+			if err := d.Set("owner", match[1]); err != nil {
+				return nil, err
+			}
+
+			meta, err := withOwner(d, meta)
+			if err != nil {
+				return nil, err
+			}
+
+			results, err := state(d, meta)
+			for _, result := range results {
+				if err := result.Set("owner", match[1]); err != nil {
+					return nil, err
+				}
+			}
+			return results, err
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestOwnerForOwner(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/orgs/other-org",
+			ResponseBody: `{"login": "other-org", "id": 42}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/some-user",
+			ResponseBody: `{"message": "Not Found"}`,
+			StatusCode:   404,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(&http.Client{})
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	owner := &Owner{name: "my-org", id: 1, IsOrganization: true, v3client: client, owners: newOwnerCache()}
+
+	if o, err := owner.forOwner(""); err != nil || o != owner {
+		t.Fatalf("Expected the provider owner without an override, got: %v, %v", o, err)
+	}
+	if o, err := owner.forOwner("My-Org"); err != nil || o != owner {
+		t.Fatalf("Expected the provider owner for its own name, got: %v, %v", o, err)
+	}
+
+	for i := 0; i < 2; i++ {
+		// The second lookup is answered from the cache
+		o, err := owner.forOwner("other-org")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if o.name != "other-org" || o.id != 42 || !o.IsOrganization || o.v3client != client {
+			t.Fatalf("Expected the other organization, got: %+v", o)
+		}
+	}
+
+	o, err := owner.forOwner("some-user")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if o.name != "some-user" || o.IsOrganization {
+		t.Fatalf("Expected a user owner, got: %+v", o)
+	}
+	if err := checkOrganization(o); err == nil {
+		t.Fatal("Expected checkOrganization to fail for a user owner")
+	}
+}
+
+func TestOwnerOverrideSupported(t *testing.T) {
+	p := Provider().(*schema.Provider)
+	for name := range ownerOverrideSupported {
+		r, ok := p.ResourcesMap[name]
+		if dataSource := strings.TrimPrefix(name, "data."); dataSource != name {
+			r, ok = p.DataSourcesMap[dataSource]
+		}
+		if !ok {
+			t.Errorf("%s supports the owner override but is not provided", name)
+			continue
+		}
+		if s := r.Schema["owner"]; s == nil || !s.Optional || s.Description != ownerOverrideSchema(false).Description {
+			t.Errorf("Expected %s to get the owner argument of the override", name)
+		}
+	}
+}
+
+func TestSetOwnerOverride(t *testing.T) {
+	var readAs, readID string
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			readAs, readID = meta.(*Owner).name, d.Id()
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+		},
+	}
+	setOwnerOverride(r, false)
+
+	owner := &Owner{name: "my-org", owners: newOwnerCache()}
+	owner.owners.owners["other-org"] = ownerInfo{id: 42, isOrganization: true}
+
+	d := r.TestResourceData()
+	d.SetId("other-org@my-repo:main")
+	results, err := r.Importer.State(d, owner)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if results[0].Id() != "my-repo:main" || results[0].Get("owner") != "other-org" {
+		t.Fatalf("Expected the owner to be moved from the ID to its attribute, got %q and %q", results[0].Id(), results[0].Get("owner"))
+	}
+
+	if err := r.Read(results[0], owner); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if readAs != "other-org" || readID != "my-repo:main" {
+		t.Fatalf("Expected Read to act on my-repo:main of other-org, got %s of %s", readID, readAs)
+	}
+	if results[0].Id() != "my-repo:main" {
+		t.Fatalf("Expected the ID to keep its usual format after Read, got %q", results[0].Id())
+	}
+
+	d = r.TestResourceData()
+	d.SetId("my-repo:main")
+	results, err = r.Importer.State(d, owner)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if results[0].Id() != "my-repo:main" || results[0].Get("owner") != "" {
+		t.Fatalf("Expected the import ID to be left alone, got %q and %q", results[0].Id(), results[0].Get("owner"))
+	}
+}
+
+func TestSetOwnerOverride_referencedID(t *testing.T) {
+	// A github_repository_project managed for another owner, whose ID is
+	// parsed as a number by github_project_column
+	project := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("1234")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+	setOwnerOverride(project, false)
+
+	owner := &Owner{name: "my-org", owners: newOwnerCache()}
+	owner.owners.owners["other-org"] = ownerInfo{id: 42, isOrganization: true}
+
+	d := project.TestResourceData()
+	if err := d.Set("owner", "other-org"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := project.Create(d, owner); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := strconv.ParseInt(d.Id(), 10, 64); err != nil {
+		t.Fatalf("Expected the ID of a resource with an owner to be usable as a project_id, got %q: %s", d.Id(), err)
+	}
+
+}
+
+func TestOwnerLookupConcurrency(t *testing.T) {
+	var m sync.Mutex
+	requests := map[string]int{}
+	other := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.Lock()
+		requests[r.URL.Path]++
+		m.Unlock()
+
+		// The lookup of org-a only completes once org-b is looked up
+		switch r.URL.Path {
+		case "/orgs/org-a":
+			select {
+			case <-other:
+			case <-time.After(5 * time.Second):
+				t.Errorf("Expected the lookup of another owner while %s is looked up", r.URL.Path)
+			}
+		case "/orgs/org-b":
+			close(other)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 42}`))
+	}))
+	defer ts.Close()
+
+	client := github.NewClient(&http.Client{})
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+	owner := &Owner{name: "my-org", v3client: client, owners: newOwnerCache()}
+
+	var wg sync.WaitGroup
+	lookup := func(name string) {
+		defer wg.Done()
+		if _, err := owner.forOwner(name); err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+	}
+	wg.Add(1)
+	go lookup("org-a")
+	for {
+		m.Lock()
+		started := len(requests) > 0
+		m.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	wg.Add(2)
+	go lookup("org-a")
+	go lookup("org-b")
+	wg.Wait()
+
+	if requests["/orgs/org-a"] != 1 || requests["/orgs/org-b"] != 1 {
+		t.Fatalf("Expected each owner to be looked up once, got: %v", requests)
+	}
+}
@@ -0,0 +1,184 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// The githubv4 release the provider uses predates some of the Projects (v2)
+// mutations, so their inputs are declared here under their GraphQL names.
+
+type DeleteProjectV2Input struct {
+	ProjectID githubv4.ID `json:"projectId"`
+}
+
+type CreateProjectV2FieldInput struct {
+	ProjectID              githubv4.ID                                `json:"projectId"`
+	DataType               string                                     `json:"dataType"`
+	Name                   string                                     `json:"name"`
+	SingleSelectOptions    []ProjectV2SingleSelectFieldOptionInput    `json:"singleSelectOptions,omitempty"`
+	IterationConfiguration *ProjectV2IterationFieldConfigurationInput `json:"iterationConfiguration,omitempty"`
+}
+
+type UpdateProjectV2FieldInput struct {
+	FieldID                githubv4.ID                                `json:"fieldId"`
+	Name                   string                                     `json:"name"`
+	SingleSelectOptions    []ProjectV2SingleSelectFieldOptionInput    `json:"singleSelectOptions,omitempty"`
+	IterationConfiguration *ProjectV2IterationFieldConfigurationInput `json:"iterationConfiguration,omitempty"`
+}
+
+type DeleteProjectV2FieldInput struct {
+	FieldID githubv4.ID `json:"fieldId"`
+}
+
+type ProjectV2SingleSelectFieldOptionInput struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+type ProjectV2IterationFieldConfigurationInput struct {
+	StartDate  string        `json:"startDate"`
+	Duration   int           `json:"duration"`
+	Iterations []interface{} `json:"iterations"`
+}
+
+// UpdateProjectV2ItemFieldValueInput replaces the githubv4 input, whose date
+// values are encoded as timestamps rather than the dates GitHub expects.
+type UpdateProjectV2ItemFieldValueInput struct {
+	ProjectID githubv4.ID         `json:"projectId"`
+	ItemID    githubv4.ID         `json:"itemId"`
+	FieldID   githubv4.ID         `json:"fieldId"`
+	Value     projectV2FieldValue `json:"value"`
+}
+
+type projectV2FieldValue struct {
+	Text                 *string  `json:"text,omitempty"`
+	Number               *float64 `json:"number,omitempty"`
+	Date                 *string  `json:"date,omitempty"`
+	SingleSelectOptionID *string  `json:"singleSelectOptionId,omitempty"`
+	IterationID          *string  `json:"iterationId,omitempty"`
+}
+
+// projectV2FieldDataTypes are the types of the fields which can be added to
+// a project
+var projectV2FieldDataTypes = []string{"TEXT", "NUMBER", "DATE", "SINGLE_SELECT", "ITERATION"}
+
+// projectV2OptionColors are the colors of the options of single select fields
+var projectV2OptionColors = []string{"GRAY", "BLUE", "GREEN", "YELLOW", "ORANGE", "RED", "PINK", "PURPLE"}
+
+// projectV2FieldCommon holds what every type of project field has
+type projectV2FieldCommon struct {
+	ID       githubv4.ID
+	Name     githubv4.String
+	DataType githubv4.String
+	Project  struct {
+		ID githubv4.ID
+	}
+}
+
+// projectV2FieldConfiguration queries a project field, whose type tells which
+// of its fragments holds it
+type projectV2FieldConfiguration struct {
+	Typename githubv4.String `graphql:"__typename"`
+	Field    struct {
+		projectV2FieldCommon
+	} `graphql:"... on ProjectV2Field"`
+	SingleSelectField struct {
+		projectV2FieldCommon
+		Options []struct {
+			ID          githubv4.String
+			Name        githubv4.String
+			Color       githubv4.String
+			Description githubv4.String
+		}
+	} `graphql:"... on ProjectV2SingleSelectField"`
+	IterationField struct {
+		projectV2FieldCommon
+		Configuration struct {
+			Duration   githubv4.Int
+			Iterations []struct {
+				ID        githubv4.String
+				Title     githubv4.String
+				StartDate githubv4.String
+				Duration  githubv4.Int
+			}
+		}
+	} `graphql:"... on ProjectV2IterationField"`
+}
+
+// common returns the fragment holding the field
+func (f *projectV2FieldConfiguration) common() projectV2FieldCommon {
+	switch f.Typename {
+	case "ProjectV2SingleSelectField":
+		return f.SingleSelectField.projectV2FieldCommon
+	case "ProjectV2IterationField":
+		return f.IterationField.projectV2FieldCommon
+	}
+	return f.Field.projectV2FieldCommon
+}
+
+func (f *projectV2FieldConfiguration) flattenOptions() []interface{} {
+	options := make([]interface{}, 0, len(f.SingleSelectField.Options))
+	if f.Typename != "ProjectV2SingleSelectField" {
+		return options
+	}
+	for _, o := range f.SingleSelectField.Options {
+		options = append(options, map[string]interface{}{
+			"id":          string(o.ID),
+			"name":        string(o.Name),
+			"color":       string(o.Color),
+			"description": string(o.Description),
+		})
+	}
+	return options
+}
+
+func (f *projectV2FieldConfiguration) flattenIterations() []interface{} {
+	iterations := make([]interface{}, 0, len(f.IterationField.Configuration.Iterations))
+	if f.Typename != "ProjectV2IterationField" {
+		return iterations
+	}
+	for _, i := range f.IterationField.Configuration.Iterations {
+		iterations = append(iterations, map[string]interface{}{
+			"id":         string(i.ID),
+			"title":      string(i.Title),
+			"start_date": string(i.StartDate),
+			"duration":   int(i.Duration),
+		})
+	}
+	return iterations
+}
+
+// getProjectV2OwnerID returns the node ID of the organization or user the
+// provider manages, which projects are created for
+func getProjectV2OwnerID(ctx context.Context, meta interface{}) (githubv4.ID, error) {
+	owner := meta.(*Owner)
+	variables := map[string]interface{}{
+		"login": githubv4.String(owner.name),
+	}
+
+	if owner.IsOrganization {
+		var query struct {
+			Organization struct {
+				ID githubv4.ID
+			} `graphql:"organization(login:$login)"`
+		}
+		if err := owner.v4client.Query(ctx, &query, variables); err != nil {
+			return nil, fmt.Errorf("unable to look up organization %s: %s", owner.name, err)
+		}
+		return query.Organization.ID, nil
+	}
+
+	var query struct {
+		User struct {
+			ID githubv4.ID
+		} `graphql:"user(login:$login)"`
+	}
+	if err := owner.v4client.Query(ctx, &query, variables); err != nil {
+		return nil, fmt.Errorf("unable to look up user %s: %s", owner.name, err)
+	}
+	return query.User.ID, nil
+}
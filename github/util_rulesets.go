@@ -0,0 +1,875 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The ruleset types of go-github v53 predate per-actor bypass modes and fail
+// to decode rulesets holding rule types they do not know, so rulesets are
+// sent and decoded with the types below instead.
+
+// ruleset is a repository or organization ruleset
+type ruleset struct {
+	ID           int64                 `json:"id,omitempty"`
+	NodeID       string                `json:"node_id,omitempty"`
+	Name         string                `json:"name"`
+	Target       string                `json:"target,omitempty"`
+	SourceType   string                `json:"source_type,omitempty"`
+	Source       string                `json:"source,omitempty"`
+	Enforcement  string                `json:"enforcement"`
+	BypassActors []*rulesetBypassActor `json:"bypass_actors"`
+	Conditions   *rulesetConditions    `json:"conditions,omitempty"`
+	Rules        []*rulesetRule        `json:"rules"`
+}
+
+type rulesetBypassActor struct {
+	ActorID    *int64 `json:"actor_id"`
+	ActorType  string `json:"actor_type"`
+	BypassMode string `json:"bypass_mode,omitempty"`
+}
+
+type rulesetConditions struct {
+	RefName        *rulesetRefNameCondition        `json:"ref_name,omitempty"`
+	RepositoryName *rulesetRepositoryNameCondition `json:"repository_name,omitempty"`
+	RepositoryID   *rulesetRepositoryIDCondition   `json:"repository_id,omitempty"`
+}
+
+type rulesetRefNameCondition struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+type rulesetRepositoryNameCondition struct {
+	Include   []string `json:"include"`
+	Exclude   []string `json:"exclude"`
+	Protected bool     `json:"protected"`
+}
+
+type rulesetRepositoryIDCondition struct {
+	RepositoryIDs []int64 `json:"repository_ids"`
+}
+
+// rulesetRule is a rule of a ruleset, whose parameters depend on its type
+type rulesetRule struct {
+	Type       string          `json:"type"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// branchRule is a rule applying to a branch, along with the ruleset it
+// comes from
+type branchRule struct {
+	rulesetRule
+	RulesetSourceType string `json:"ruleset_source_type"`
+	RulesetSource     string `json:"ruleset_source"`
+	RulesetID         int64  `json:"ruleset_id"`
+}
+
+// ruleSuite is the evaluation of the rulesets applying to a push
+type ruleSuite struct {
+	ID               int64  `json:"id"`
+	ActorID          int64  `json:"actor_id"`
+	ActorName        string `json:"actor_name"`
+	BeforeSHA        string `json:"before_sha"`
+	AfterSHA         string `json:"after_sha"`
+	Ref              string `json:"ref"`
+	RepositoryID     int64  `json:"repository_id"`
+	RepositoryName   string `json:"repository_name"`
+	PushedAt         string `json:"pushed_at"`
+	Result           string `json:"result"`
+	EvaluationResult string `json:"evaluation_result"`
+}
+
+type rulesetUpdateParameters struct {
+	UpdateAllowsFetchAndMerge bool `json:"update_allows_fetch_and_merge"`
+}
+
+type rulesetRequiredDeploymentsParameters struct {
+	RequiredDeploymentEnvironments []string `json:"required_deployment_environments"`
+}
+
+type rulesetPullRequestParameters struct {
+	DismissStaleReviewsOnPush      bool `json:"dismiss_stale_reviews_on_push"`
+	RequireCodeOwnerReview         bool `json:"require_code_owner_review"`
+	RequireLastPushApproval        bool `json:"require_last_push_approval"`
+	RequiredApprovingReviewCount   int  `json:"required_approving_review_count"`
+	RequiredReviewThreadResolution bool `json:"required_review_thread_resolution"`
+}
+
+type rulesetRequiredStatusCheck struct {
+	Context       string `json:"context"`
+	IntegrationID *int64 `json:"integration_id,omitempty"`
+}
+
+type rulesetRequiredStatusChecksParameters struct {
+	RequiredStatusChecks             []rulesetRequiredStatusCheck `json:"required_status_checks"`
+	StrictRequiredStatusChecksPolicy bool                         `json:"strict_required_status_checks_policy"`
+}
+
+type rulesetPatternParameters struct {
+	Name     string `json:"name,omitempty"`
+	Negate   bool   `json:"negate"`
+	Operator string `json:"operator"`
+	Pattern  string `json:"pattern"`
+}
+
+// rulesetSimpleRules are the rules without parameters, which are switched on
+// by a boolean argument of the same name
+var rulesetSimpleRules = []string{
+	"creation",
+	"deletion",
+	"required_linear_history",
+	"required_signatures",
+	"non_fast_forward",
+}
+
+// rulesetPatternRules are the rules restricting a name or metadata of commits
+// to a pattern
+var rulesetPatternRules = []string{
+	"commit_message_pattern",
+	"commit_author_email_pattern",
+	"committer_email_pattern",
+	"branch_name_pattern",
+	"tag_name_pattern",
+}
+
+// rulesetRequest sends a request for a ruleset to path, which is relative to
+// the API root, decoding the ruleset of the response if any
+func rulesetRequest(ctx context.Context, client *github.Client, method, path string, body *ruleset) (*ruleset, *github.Response, error) {
+	req, err := client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if method == "DELETE" {
+		resp, err := client.Do(ctx, req, nil)
+		return nil, resp, err
+	}
+
+	result := new(ruleset)
+	resp, err := client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+// listRulesetPages requests every page of a list of rulesets, rules or rule
+// suites at path, which is relative to the API root, decoding each page with
+// decode
+func listRulesetPages(ctx context.Context, client *github.Client, path string, query url.Values, decode func(req *http.Request) (*github.Response, error)) error {
+	query.Set("per_page", strconv.Itoa(maxPerPage))
+
+	for page := 1; page != 0; {
+		query.Set("page", strconv.Itoa(page))
+		req, err := client.NewRequest("GET", path+"?"+query.Encode(), nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := decode(req)
+		if err != nil {
+			return err
+		}
+		page = resp.NextPage
+	}
+
+	return nil
+}
+
+// listRulesets lists every ruleset at path. Rulesets are listed without
+// their conditions and rules.
+func listRulesets(ctx context.Context, client *github.Client, path string, query url.Values) ([]*ruleset, error) {
+	all := make([]*ruleset, 0)
+	err := listRulesetPages(ctx, client, path, query, func(req *http.Request) (*github.Response, error) {
+		var rulesets []*ruleset
+		resp, err := client.Do(ctx, req, &rulesets)
+		all = append(all, rulesets...)
+		return resp, err
+	})
+	return all, err
+}
+
+// listBranchRules lists the rules applying to a branch of a repository
+func listBranchRules(ctx context.Context, client *github.Client, owner, repo, branch string) ([]*branchRule, error) {
+	path := fmt.Sprintf("repos/%s/%s/rules/branches/%s", owner, repo, url.PathEscape(branch))
+
+	all := make([]*branchRule, 0)
+	err := listRulesetPages(ctx, client, path, url.Values{}, func(req *http.Request) (*github.Response, error) {
+		var rules []*branchRule
+		resp, err := client.Do(ctx, req, &rules)
+		all = append(all, rules...)
+		return resp, err
+	})
+	return all, err
+}
+
+// listRuleSuites lists the rule suites at path matching query
+func listRuleSuites(ctx context.Context, client *github.Client, path string, query url.Values) ([]*ruleSuite, error) {
+	all := make([]*ruleSuite, 0)
+	err := listRulesetPages(ctx, client, path, query, func(req *http.Request) (*github.Response, error) {
+		var suites []*ruleSuite
+		resp, err := client.Do(ctx, req, &suites)
+		all = append(all, suites...)
+		return resp, err
+	})
+	return all, err
+}
+
+// rulesetsDataSourceSchema describes the rulesets listed by a data source
+func rulesetsDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ruleset_id": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"node_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"target": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"enforcement": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"source_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"source": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenRulesetsDataSource(rulesets []*ruleset) []interface{} {
+	results := make([]interface{}, 0, len(rulesets))
+	for _, rs := range rulesets {
+		results = append(results, map[string]interface{}{
+			"ruleset_id":  int(rs.ID),
+			"node_id":     rs.NodeID,
+			"name":        rs.Name,
+			"target":      rs.Target,
+			"enforcement": rs.Enforcement,
+			"source_type": rs.SourceType,
+			"source":      rs.Source,
+		})
+	}
+	return results
+}
+
+// ruleSuitesDataSourceSchema describes the arguments and results of a rule
+// suites data source
+func ruleSuitesDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ref": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return the rule suites of pushes to this ref.",
+		},
+		"time_period": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "day",
+			ValidateFunc: validation.StringInSlice([]string{"hour", "day", "week", "month"}, false),
+			Description:  "The time period to return rule suites for, one of `hour`, `day`, `week` or `month`.",
+		},
+		"actor_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return the rule suites of pushes by this actor.",
+		},
+		"rule_suite_result": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "all",
+			ValidateFunc: validation.StringInSlice([]string{"pass", "fail", "bypass", "all"}, false),
+			Description:  "Only return the rule suites with this result, one of `pass`, `fail`, `bypass` or `all`.",
+		},
+		"rule_suites": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"actor_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"actor_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"before_sha": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"after_sha": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"ref": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"repository_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"repository_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"pushed_at": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"result": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"evaluation_result": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ruleSuitesQuery returns the query parameters of a rule suites data source
+func ruleSuitesQuery(d *schema.ResourceData) url.Values {
+	query := url.Values{}
+	for _, name := range []string{"ref", "time_period", "actor_name", "rule_suite_result"} {
+		if v := d.Get(name).(string); v != "" {
+			query.Set(name, v)
+		}
+	}
+	return query
+}
+
+func flattenRuleSuites(suites []*ruleSuite) []interface{} {
+	results := make([]interface{}, 0, len(suites))
+	for _, suite := range suites {
+		results = append(results, map[string]interface{}{
+			"id":                int(suite.ID),
+			"actor_id":          int(suite.ActorID),
+			"actor_name":        suite.ActorName,
+			"before_sha":        suite.BeforeSHA,
+			"after_sha":         suite.AfterSHA,
+			"ref":               suite.Ref,
+			"repository_id":     int(suite.RepositoryID),
+			"repository_name":   suite.RepositoryName,
+			"pushed_at":         suite.PushedAt,
+			"result":            suite.Result,
+			"evaluation_result": suite.EvaluationResult,
+		})
+	}
+	return results
+}
+
+func rulesetBypassActorsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "The actors which can bypass the rules of the ruleset.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"actor_id": {
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "The ID of the actor: a repository role, team or GitHub App installation. Use 1 for `OrganizationAdmin`.",
+				},
+				"actor_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"RepositoryRole", "Team", "Integration", "OrganizationAdmin"}, false),
+					Description:  "The type of the actor, one of `RepositoryRole`, `Team`, `Integration` or `OrganizationAdmin`.",
+				},
+				"bypass_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "always",
+					ValidateFunc: validation.StringInSlice([]string{"always", "pull_request"}, false),
+					Description:  "When the actor can bypass the rules, either `always` or only for `pull_request`s.",
+				},
+			},
+		},
+	}
+}
+
+func rulesetRefNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: "The refs the ruleset applies to.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"include": {
+					Type:        schema.TypeList,
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Ref names or patterns to include. `~DEFAULT_BRANCH` matches the default branch and `~ALL` every ref.",
+				},
+				"exclude": {
+					Type:        schema.TypeList,
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Ref names or patterns to exclude.",
+				},
+			},
+		},
+	}
+}
+
+func rulesetPatternSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "How the rule is shown to users.",
+				},
+				"negate": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Whether the rule fails when the pattern matches instead of when it does not.",
+				},
+				"operator": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"starts_with", "ends_with", "contains", "regex"}, false),
+					Description:  "How to match the pattern, one of `starts_with`, `ends_with`, `contains` or `regex`.",
+				},
+				"pattern": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The pattern to match.",
+				},
+			},
+		},
+	}
+}
+
+func rulesetRulesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: "The rules of the ruleset.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"creation": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Only allow users with bypass permission to create matching refs.",
+				},
+				"update": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Only allow users with bypass permission to update matching refs.",
+				},
+				"update_allows_fetch_and_merge": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether the `update` rule lets branches be updated by fetching and merging from upstream.",
+				},
+				"deletion": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Only allow users with bypass permission to delete matching refs.",
+				},
+				"required_linear_history": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Prevent merge commits from being pushed to matching refs.",
+				},
+				"required_signatures": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Require commits pushed to matching refs to have verified signatures.",
+				},
+				"non_fast_forward": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Prevent users with push access from force pushing to matching refs.",
+				},
+				"required_deployments": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Require deployments to environments to succeed before refs can be merged into matching refs.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"required_deployment_environments": {
+								Type:        schema.TypeList,
+								Required:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "The environments which must be deployed to successfully.",
+							},
+						},
+					},
+				},
+				"pull_request": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Require changes to matching refs to be made through pull requests.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"dismiss_stale_reviews_on_push": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Dismiss approving reviews when new commits are pushed.",
+							},
+							"require_code_owner_review": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Require an approving review of the code owners of the changed files.",
+							},
+							"require_last_push_approval": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Require the most recent push to be approved by someone other than its author.",
+							},
+							"required_approving_review_count": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      0,
+								ValidateFunc: validation.IntBetween(0, 10),
+								Description:  "The number of approving reviews required.",
+							},
+							"required_review_thread_resolution": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Require every review thread to be resolved.",
+							},
+						},
+					},
+				},
+				"required_status_checks": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Require status checks to pass before refs can be merged into matching refs.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"required_check": {
+								Type:        schema.TypeSet,
+								Required:    true,
+								Description: "The status checks which must pass.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"context": {
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "The name of the status check.",
+										},
+										"integration_id": {
+											Type:        schema.TypeInt,
+											Optional:    true,
+											Default:     0,
+											Description: "The ID of the GitHub App which must report the status check. Any source is accepted when 0.",
+										},
+									},
+								},
+							},
+							"strict_required_status_checks_policy": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Require refs to be up to date with the matching ref before merging.",
+							},
+						},
+					},
+				},
+				"commit_message_pattern":      rulesetPatternSchema("Restrict the messages of commits pushed to matching refs."),
+				"commit_author_email_pattern": rulesetPatternSchema("Restrict the author email addresses of commits pushed to matching refs."),
+				"committer_email_pattern":     rulesetPatternSchema("Restrict the committer email addresses of commits pushed to matching refs."),
+				"branch_name_pattern":         rulesetPatternSchema("Restrict the names of matching branches. Only for rulesets targeting branches."),
+				"tag_name_pattern":            rulesetPatternSchema("Restrict the names of matching tags. Only for rulesets targeting tags."),
+			},
+		},
+	}
+}
+
+func expandRulesetBypassActors(v interface{}) []*rulesetBypassActor {
+	actors := make([]*rulesetBypassActor, 0)
+	for _, a := range v.([]interface{}) {
+		actor := a.(map[string]interface{})
+		actors = append(actors, &rulesetBypassActor{
+			ActorID:    github.Int64(int64(actor["actor_id"].(int))),
+			ActorType:  actor["actor_type"].(string),
+			BypassMode: actor["bypass_mode"].(string),
+		})
+	}
+	return actors
+}
+
+func flattenRulesetBypassActors(actors []*rulesetBypassActor) []interface{} {
+	result := make([]interface{}, 0, len(actors))
+	for _, actor := range actors {
+		var actorID int64
+		if actor.ActorID != nil {
+			actorID = *actor.ActorID
+		}
+		bypassMode := actor.BypassMode
+		if bypassMode == "" {
+			bypassMode = "always"
+		}
+		result = append(result, map[string]interface{}{
+			"actor_id":    int(actorID),
+			"actor_type":  actor.ActorType,
+			"bypass_mode": bypassMode,
+		})
+	}
+	return result
+}
+
+func expandRulesetRefName(v interface{}) *rulesetRefNameCondition {
+	list := v.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	refName := list[0].(map[string]interface{})
+	return &rulesetRefNameCondition{
+		Include: expandStringList(refName["include"].([]interface{})),
+		Exclude: expandStringList(refName["exclude"].([]interface{})),
+	}
+}
+
+func flattenRulesetRefName(refName *rulesetRefNameCondition) []interface{} {
+	if refName == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"include": refName.Include,
+			"exclude": refName.Exclude,
+		},
+	}
+}
+
+// expandRulesetParameters marshals the parameters of a rule, which come from
+// a struct of this file and so cannot fail to encode
+func expandRulesetParameters(parameters interface{}) json.RawMessage {
+	encoded, _ := json.Marshal(parameters)
+	return encoded
+}
+
+func expandRulesetRules(v interface{}) []*rulesetRule {
+	rules := make([]*rulesetRule, 0)
+	list := v.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return rules
+	}
+	r := list[0].(map[string]interface{})
+
+	for _, ruleType := range rulesetSimpleRules {
+		if r[ruleType].(bool) {
+			rules = append(rules, &rulesetRule{Type: ruleType})
+		}
+	}
+
+	if r["update"].(bool) {
+		rules = append(rules, &rulesetRule{
+			Type: "update",
+			Parameters: expandRulesetParameters(rulesetUpdateParameters{
+				UpdateAllowsFetchAndMerge: r["update_allows_fetch_and_merge"].(bool),
+			}),
+		})
+	}
+
+	if v, ok := r["required_deployments"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		deployments := v[0].(map[string]interface{})
+		rules = append(rules, &rulesetRule{
+			Type: "required_deployments",
+			Parameters: expandRulesetParameters(rulesetRequiredDeploymentsParameters{
+				RequiredDeploymentEnvironments: expandStringList(deployments["required_deployment_environments"].([]interface{})),
+			}),
+		})
+	}
+
+	if v, ok := r["pull_request"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		pullRequest := v[0].(map[string]interface{})
+		rules = append(rules, &rulesetRule{
+			Type: "pull_request",
+			Parameters: expandRulesetParameters(rulesetPullRequestParameters{
+				DismissStaleReviewsOnPush:      pullRequest["dismiss_stale_reviews_on_push"].(bool),
+				RequireCodeOwnerReview:         pullRequest["require_code_owner_review"].(bool),
+				RequireLastPushApproval:        pullRequest["require_last_push_approval"].(bool),
+				RequiredApprovingReviewCount:   pullRequest["required_approving_review_count"].(int),
+				RequiredReviewThreadResolution: pullRequest["required_review_thread_resolution"].(bool),
+			}),
+		})
+	}
+
+	if v, ok := r["required_status_checks"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statusChecks := v[0].(map[string]interface{})
+		checks := make([]rulesetRequiredStatusCheck, 0)
+		for _, c := range statusChecks["required_check"].(*schema.Set).List() {
+			check := c.(map[string]interface{})
+			statusCheck := rulesetRequiredStatusCheck{Context: check["context"].(string)}
+			if integrationID := check["integration_id"].(int); integrationID != 0 {
+				statusCheck.IntegrationID = github.Int64(int64(integrationID))
+			}
+			checks = append(checks, statusCheck)
+		}
+		rules = append(rules, &rulesetRule{
+			Type: "required_status_checks",
+			Parameters: expandRulesetParameters(rulesetRequiredStatusChecksParameters{
+				RequiredStatusChecks:             checks,
+				StrictRequiredStatusChecksPolicy: statusChecks["strict_required_status_checks_policy"].(bool),
+			}),
+		})
+	}
+
+	for _, ruleType := range rulesetPatternRules {
+		if v, ok := r[ruleType].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			pattern := v[0].(map[string]interface{})
+			rules = append(rules, &rulesetRule{
+				Type: ruleType,
+				Parameters: expandRulesetParameters(rulesetPatternParameters{
+					Name:     pattern["name"].(string),
+					Negate:   pattern["negate"].(bool),
+					Operator: pattern["operator"].(string),
+					Pattern:  pattern["pattern"].(string),
+				}),
+			})
+		}
+	}
+
+	return rules
+}
+
+func flattenRulesetRules(rules []*rulesetRule) ([]interface{}, error) {
+	r := map[string]interface{}{}
+	for _, ruleType := range rulesetSimpleRules {
+		r[ruleType] = false
+	}
+	r["update"] = false
+	r["update_allows_fetch_and_merge"] = false
+
+	for _, rule := range rules {
+		switch rule.Type {
+		case "creation", "deletion", "required_linear_history", "required_signatures", "non_fast_forward":
+			r[rule.Type] = true
+
+		case "update":
+			var parameters rulesetUpdateParameters
+			if len(rule.Parameters) > 0 {
+				if err := json.Unmarshal(rule.Parameters, &parameters); err != nil {
+					return nil, err
+				}
+			}
+			r["update"] = true
+			r["update_allows_fetch_and_merge"] = parameters.UpdateAllowsFetchAndMerge
+
+		case "required_deployments":
+			var parameters rulesetRequiredDeploymentsParameters
+			if err := json.Unmarshal(rule.Parameters, &parameters); err != nil {
+				return nil, err
+			}
+			r["required_deployments"] = []interface{}{
+				map[string]interface{}{
+					"required_deployment_environments": parameters.RequiredDeploymentEnvironments,
+				},
+			}
+
+		case "pull_request":
+			var parameters rulesetPullRequestParameters
+			if err := json.Unmarshal(rule.Parameters, &parameters); err != nil {
+				return nil, err
+			}
+			r["pull_request"] = []interface{}{
+				map[string]interface{}{
+					"dismiss_stale_reviews_on_push":     parameters.DismissStaleReviewsOnPush,
+					"require_code_owner_review":         parameters.RequireCodeOwnerReview,
+					"require_last_push_approval":        parameters.RequireLastPushApproval,
+					"required_approving_review_count":   parameters.RequiredApprovingReviewCount,
+					"required_review_thread_resolution": parameters.RequiredReviewThreadResolution,
+				},
+			}
+
+		case "required_status_checks":
+			var parameters rulesetRequiredStatusChecksParameters
+			if err := json.Unmarshal(rule.Parameters, &parameters); err != nil {
+				return nil, err
+			}
+			checks := make([]interface{}, 0, len(parameters.RequiredStatusChecks))
+			for _, check := range parameters.RequiredStatusChecks {
+				var integrationID int64
+				if check.IntegrationID != nil {
+					integrationID = *check.IntegrationID
+				}
+				checks = append(checks, map[string]interface{}{
+					"context":        check.Context,
+					"integration_id": int(integrationID),
+				})
+			}
+			r["required_status_checks"] = []interface{}{
+				map[string]interface{}{
+					"required_check":                       checks,
+					"strict_required_status_checks_policy": parameters.StrictRequiredStatusChecksPolicy,
+				},
+			}
+
+		case "commit_message_pattern", "commit_author_email_pattern", "committer_email_pattern", "branch_name_pattern", "tag_name_pattern":
+			var parameters rulesetPatternParameters
+			if err := json.Unmarshal(rule.Parameters, &parameters); err != nil {
+				return nil, err
+			}
+			r[rule.Type] = []interface{}{
+				map[string]interface{}{
+					"name":     parameters.Name,
+					"negate":   parameters.Negate,
+					"operator": parameters.Operator,
+					"pattern":  parameters.Pattern,
+				},
+			}
+
+		default:
+			log.Printf("[WARN] Ignoring rule of unsupported type %s", rule.Type)
+		}
+	}
+
+	return []interface{}{r}, nil
+}
@@ -1,6 +1,9 @@
 package github
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"unicode"
 )
@@ -155,3 +158,25 @@ func TestAccGithubUtilValidateSecretName(t *testing.T) {
 		}
 	}
 }
+
+func TestGithubUtilNoContextTODO(t *testing.T) {
+	// API calls made with context.TODO() ignore the timeouts block of the
+	// resource and are not cancelled when Terraform is interrupted.
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(src), "context.TODO()") {
+			t.Errorf("%s uses context.TODO(), use operationContext or stopContext instead", file)
+		}
+	}
+}
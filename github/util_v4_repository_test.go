@@ -2,6 +2,7 @@ package github
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -172,7 +173,7 @@ func TestGetRepositoryIDPositiveMatches(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		got, err := getRepositoryID(tc.Provided, &meta)
+		got, err := getRepositoryID(context.Background(), tc.Provided, &meta)
 		if err != nil {
 			// We expect to error out on these repos
 			if tc.Expected != "" {
@@ -8,4 +8,9 @@ import (
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: github.Provider})
+
+	// Terraform stops the provider at the end of each operation. The process
+	// may also be killed, so the audit log summary file is kept up to date
+	// after every call and this only appends the final summary to the logs.
+	github.CloseAuditLogs()
 }